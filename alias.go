@@ -4,11 +4,21 @@ import (
 	"image"
 
 	"github.com/jaypipes/gt/core"
+	"github.com/jaypipes/gt/core/action"
 	"github.com/jaypipes/gt/core/application"
 	"github.com/jaypipes/gt/core/border"
 	gtcontext "github.com/jaypipes/gt/core/context"
+	"github.com/jaypipes/gt/core/gradient"
 	"github.com/jaypipes/gt/core/key"
+	"github.com/jaypipes/gt/core/keymap"
 	gtlog "github.com/jaypipes/gt/core/log"
+	"github.com/jaypipes/gt/core/mode"
+	"github.com/jaypipes/gt/core/palette"
+	"github.com/jaypipes/gt/core/session"
+	"github.com/jaypipes/gt/core/shadow"
+	"github.com/jaypipes/gt/core/style"
+	"github.com/jaypipes/gt/core/stylesheet"
+	"github.com/jaypipes/gt/core/theme"
 	"github.com/jaypipes/gt/core/view"
 	"github.com/jaypipes/gt/element"
 	"github.com/jaypipes/gt/element/div"
@@ -23,12 +33,40 @@ var (
 	Warn  = gtlog.Warn
 )
 
-var ContextFromEnv = gtcontext.FromEnv
+var (
+	ContextFromEnv = gtcontext.FromEnv
+	NewContext     = gtcontext.New
+	WithThemeName  = gtcontext.WithThemeName
+)
+
+var (
+	WithRecordPath  = gtcontext.WithRecordPath
+	WithReplayPath  = gtcontext.WithReplayPath
+	WithReplaySpeed = gtcontext.WithReplaySpeed
+	WithReplayStep  = gtcontext.WithReplayStep
+	WithReplayExit  = gtcontext.WithReplayExit
+	RecordPath      = gtcontext.RecordPath
+	ReplayPath      = gtcontext.ReplayPath
+)
 
 type Application = application.Application
 
 var (
-	NewApplication = application.New
+	NewApplication           = application.New
+	NewApplicationWithScreen = application.NewWithScreen
+)
+
+type SessionEvent = session.Event
+type SessionPlayer = types.SessionPlayer
+type HeadlessScreen = session.HeadlessScreen
+
+var (
+	LoadSession        = session.Load
+	LoadSessionFile    = session.LoadFile
+	SessionSize        = session.Size
+	NewSessionRecorder = session.NewRecorder
+	NewSessionPlayer   = session.NewPlayer
+	NewHeadlessScreen  = session.NewHeadlessScreen
 )
 
 type View = view.View
@@ -40,9 +78,56 @@ var (
 type Key = types.Key
 type KeyCode = types.KeyCode
 type KeyModifiers = types.KeyModifiers
+type KeyShortcut = types.KeyShortcut
+type KeyShortcutCallback = types.KeyShortcutCallback
+
+var (
+	NewKey      = key.New
+	KeySequence = key.Sequence
+	ParseKey    = key.Parse
+	MustKey     = key.MustParse
+)
+
+type Mode = types.Mode
+type ModeChangeCallback = types.ModeChangeCallback
 
 var (
-	NewKey = key.New
+	NewMode        = mode.New
+	WithModeKeymap = mode.WithKeymap
+	WithModeInsert = mode.WithInsert
+)
+
+type Action = types.Action
+type ActionCallback = types.ActionCallback
+type Keymap = types.Keymap
+type KeymapScope = types.KeymapScope
+type KeyBinding = types.KeyBinding
+type KeymapConflict = keymap.Conflict
+type KeymapFormat = keymap.Format
+type ResolvedKeyBinding = types.ResolvedKeyBinding
+type KeyBindingConflict = types.KeyBindingConflict
+type KeyBindingProvider = types.KeyBindingProvider
+
+const (
+	KeymapFormatJSON = keymap.FormatJSON
+	KeymapFormatYAML = keymap.FormatYAML
+	KeymapFormatTOML = keymap.FormatTOML
+)
+
+var (
+	NewAction             = action.New
+	WithActionDescription = action.WithDescription
+	WithActionCallback    = action.WithCallback
+
+	NewKeymap        = keymap.New
+	WithKeyBinding   = keymap.WithBinding
+	LoadKeymap       = keymap.Load
+	LoadKeymapFile   = keymap.LoadFile
+	MergeKeymaps     = keymap.Merge
+	CheckKeymap      = keymap.Check
+	ApplicationScope = keymap.Application
+	ViewScope        = keymap.View
+	ClassScope       = keymap.Class
 )
 
 const (
@@ -50,6 +135,9 @@ const (
 	KeyModifierShift = types.KeyModifierShift
 	KeyModifierCtrl  = types.KeyModifierCtrl
 	KeyModifierAlt   = types.KeyModifierAlt
+	KeyModifierMeta  = types.KeyModifierMeta
+	KeyModifierSuper = types.KeyModifierSuper
+	KeyModifierHyper = types.KeyModifierHyper
 
 	KeyCodeBackspace  = types.KeyCodeBackspace
 	KeyCodeTab        = types.KeyCodeTab
@@ -164,6 +252,9 @@ var (
 	WithID                    = element.WithID
 	WithFocusable             = element.WithFocusable
 	WithDisabled              = element.WithDisabled
+	WithSelected              = element.WithSelected
+	WithChecked               = element.WithChecked
+	WithInvalid               = element.WithInvalid
 	WithBounds                = element.WithBounds
 	WithAbsolutePosition      = element.WithAbsolutePosition
 	WithSize                  = element.WithSize
@@ -176,20 +267,39 @@ var (
 	WithWhitespace            = element.WithWhitespace
 	WithPadding               = element.WithPadding
 	WithBorder                = element.WithBorder
+	WithBorderCollapse        = element.WithBorderCollapse
 	WithDisabledBorder        = element.WithDisabledBorder
 	WithFocusedBorder         = element.WithFocusedBorder
 	WithHoveredBorder         = element.WithHoveredBorder
+	WithPressedBorder         = element.WithPressedBorder
+	WithSelectedBorder        = element.WithSelectedBorder
+	WithCheckedBorder         = element.WithCheckedBorder
+	WithInvalidBorder         = element.WithInvalidBorder
 	WithBorderForegroundColor = element.WithBorderForegroundColor
 	WithBorderBackgroundColor = element.WithBorderBackgroundColor
+	WithBorderTitle           = element.WithBorderTitle
+	WithShadow                = element.WithShadow
+	WithBorderFooter          = element.WithBorderFooter
 	WithTheme                 = element.WithTheme
+	WithThemeClass            = element.WithThemeClass
+	WithStylesheet            = element.WithStylesheet
 	WithMotif                 = element.WithMotif
 	WithStyle                 = element.WithStyle
 	WithDisabledStyle         = element.WithDisabledStyle
 	WithFocusedStyle          = element.WithFocusedStyle
 	WithHoveredStyle          = element.WithHoveredStyle
+	WithPressedStyle          = element.WithPressedStyle
+	WithSelectedStyle         = element.WithSelectedStyle
+	WithCheckedStyle          = element.WithCheckedStyle
+	WithInvalidStyle          = element.WithInvalidStyle
 	WithForegroundColor       = element.WithForegroundColor
 	WithBackgroundColor       = element.WithBackgroundColor
+	WithForegroundGradient    = element.WithForegroundGradient
+	WithBackgroundGradient    = element.WithBackgroundGradient
 	WithTextContent           = element.WithTextContent
+	WithANSIMode              = element.WithANSIMode
+	WithHyperlink             = element.WithHyperlink
+	WithKeyShortcut           = element.WithKeyShortcut
 )
 
 type Div = div.Div
@@ -231,6 +341,40 @@ const (
 	AlignmentMiddleCenter = types.AlignmentMiddleCenter
 )
 
+type Display = types.Display
+
+const (
+	DisplayInline      = types.DisplayInline
+	DisplayBlock       = types.DisplayBlock
+	DisplayInlineBlock = types.DisplayInlineBlock
+)
+
+type Property = types.Property
+
+type Hyperlink = types.Hyperlink
+
+const (
+	PropertyForegroundColor = types.PropertyForegroundColor
+	PropertyBackgroundColor = types.PropertyBackgroundColor
+	PropertyBold            = types.PropertyBold
+	PropertyItalic          = types.PropertyItalic
+	PropertyDim             = types.PropertyDim
+	PropertyUnderline       = types.PropertyUnderline
+	PropertyStrikethrough   = types.PropertyStrikethrough
+	PropertyBlink           = types.PropertyBlink
+	PropertyReverse         = types.PropertyReverse
+	PropertyHyperlink       = types.PropertyHyperlink
+	PropertyBorder          = types.PropertyBorder
+	PropertyPadding         = types.PropertyPadding
+	PropertyWidth           = types.PropertyWidth
+	PropertyHeight          = types.PropertyHeight
+	PropertyAlignment       = types.PropertyAlignment
+	PropertyDisplay         = types.PropertyDisplay
+
+	Inherit = types.Inherit
+	Initial = types.Initial
+)
+
 type Whitespace types.Whitespace
 
 const (
@@ -240,6 +384,39 @@ const (
 	WhitespaceWrapLine  = types.WhitespaceWrapLine
 )
 
+type ANSIMode = types.ANSIMode
+
+const (
+	ANSIModeVerbatim = types.ANSIModeVerbatim
+	ANSIModeStrip    = types.ANSIModeStrip
+	ANSIModeHonor    = types.ANSIModeHonor
+)
+
+type ThemeClass = types.ThemeClass
+type ThemeFormat = theme.Format
+type PaletteFormat = palette.Format
+
+const (
+	ThemeClassNone       = types.ThemeClassNone
+	ThemeClassInput      = types.ThemeClassInput
+	ThemeClassNavigation = types.ThemeClassNavigation
+	ThemeClassPrimary    = types.ThemeClassPrimary
+	ThemeClassSecondary  = types.ThemeClassSecondary
+	ThemeClassHelp       = types.ThemeClassHelp
+
+	ThemeFormatJSON = theme.FormatJSON
+	ThemeFormatYAML = theme.FormatYAML
+	ThemeFormatTOML = theme.FormatTOML
+
+	PaletteFormatBase16    = palette.FormatBase16
+	PaletteFormatITerm2    = palette.FormatITerm2
+	PaletteFormatAlacritty = palette.FormatAlacritty
+	PaletteFormatKitty     = palette.FormatKitty
+
+	ContrastAA  = palette.ContrastAA
+	ContrastAAA = palette.ContrastAAA
+)
+
 type (
 	Cell                = types.Cell
 	Cursor              = types.Cursor
@@ -251,6 +428,12 @@ type (
 	DimensionConstraint = types.DimensionConstraint
 	SizeConstraint      = types.SizeConstraint
 	Border              = types.Border
+	BorderLabel         = types.BorderLabel
+	Shadow              = types.Shadow
+	Gradient            = types.Gradient
+	Theme               = types.Theme
+	Stylesheet          = types.Stylesheet
+	Motif               = types.Motif
 	Style               = types.Style
 	Text                = types.Text
 )
@@ -315,4 +498,57 @@ var (
 	HiddenBorder         = border.Hidden
 	MarkdownBorder       = border.Markdown
 	ASCIIBorder          = border.ASCII
+	DashedBorder         = border.Dashed
+	HeavyDashedBorder    = border.HeavyDashed
+	DottedBorder         = border.Dotted
+	HeavyDottedBorder    = border.HeavyDotted
+
+	BorderFromSpec     = border.FromSpec
+	MustBorderFromSpec = border.MustFromSpec
+	BorderSides        = border.Sides
+	RegisterBorder     = border.Register
+	LookupBorder       = border.Lookup
+	BorderNames        = border.Names
+
+	NewBorderLabel      = border.NewLabel
+	WithLabelAlignment  = border.WithLabelAlignment
+	WithLabelStyle      = border.WithLabelStyle
+	WithLabelDelimiters = border.WithLabelDelimiters
+
+	NewShadow = shadow.New
+
+	Link = style.Link
+
+	NewTheme        = theme.New
+	WithThemeMotif  = theme.WithMotif
+	WithThemeStyle  = theme.WithStyle
+	WithThemeBorder = theme.WithBorder
+	LoadTheme       = theme.Load
+	LoadThemeFile   = theme.LoadFile
+	ExportTheme     = theme.Export
+	ExportThemeFile = theme.ExportFile
+	ThemeFromScheme = theme.FromScheme
+	RegisterTheme   = theme.Register
+	SelectTheme     = theme.Select
+	ThemeNames      = theme.Names
+
+	LookupTheme      = theme.Lookup
+	LintTheme        = theme.Lint
+	FixThemeContrast = theme.FixContrast
+
+	LoadPalette     = palette.Load
+	LoadPaletteFile = palette.LoadFile
+	ContrastRatio   = palette.ContrastRatio
+	AdjustContrast  = palette.AdjustContrast
+
+	NewStylesheet      = stylesheet.New
+	NewStylesheetRule  = stylesheet.NewRule
+	WithStylesheetRule = stylesheet.WithRule
+	ComputedStyle      = stylesheet.ComputedStyle
+
+	NewGradient            = gradient.New
+	WithGradientDirection  = gradient.WithDirection
+	WithGradientColorSpace = gradient.WithColorSpace
+	WithGradientStop       = gradient.WithStop
+	WithGradientColors     = gradient.WithColors
 )
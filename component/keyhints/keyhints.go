@@ -0,0 +1,116 @@
+package keyhints
+
+import (
+	"context"
+	"sort"
+
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/jaypipes/gt/core"
+	gtlog "github.com/jaypipes/gt/core/log"
+	"github.com/jaypipes/gt/core/style"
+	"github.com/jaypipes/gt/element"
+	"github.com/jaypipes/gt/types"
+)
+
+const (
+	ElementClass = "gt.keyhints"
+	// separator is placed between hints.
+	separator = "  "
+)
+
+// New returns a new KeyHints instance with the given options.
+func New(
+	ctx context.Context,
+	opts ...types.ElementWithOption,
+) *KeyHints {
+	e := element.New(ctx, ElementClass)
+	h := &KeyHints{Element: e}
+	// KeyHints default to a single line the width of the parent container,
+	// like the footers of htop or lazygit.
+	h.SetDisplay(types.DisplayBlock)
+	h.SetHeight(core.Fixed(1))
+	h.SetWhitespace(types.WhitespaceWrapNever)
+	h.SetThemeClass(types.ThemeClassNavigation)
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// KeyHints is a Component that renders a compact, one line list of the key
+// bindings that currently win, e.g. "^S save  q quit", with the bindings of
+// the focused Element first, then those of the active View and then those of
+// the Application.
+//
+// The bindings are read from the ScreenHandler, typically the Application,
+// every time the KeyHints is rendered, so the hints follow changes to the
+// focus. Bindings without a description are not shown.
+type KeyHints struct {
+	element.Element
+}
+
+// Render implements the types.Renderable interface
+func (h *KeyHints) Render(ctx context.Context, handler types.ScreenHandler) {
+	gtlog.Debug(ctx, "KeyHints.Render[%s]: bounds=%s", h.Tag(), h.Bounds())
+	h.RenderBox(ctx, handler)
+	p, ok := handler.(types.KeyBindingProvider)
+	if !ok {
+		return
+	}
+	inner := h.InnerBounds()
+	descStyle := style.TCell(h.Style())
+	keyStyle := descStyle.Reverse(true)
+	screen := handler.Screen()
+	x, y := inner.Min.X, inner.Min.Y
+	for _, b := range hints(p.KeyBindings()) {
+		k := b.Key.String()
+		w := ansi.StringWidth(k) + 1 + ansi.StringWidth(b.Description)
+		if x > inner.Min.X {
+			w += len(separator)
+		}
+		if x+w > inner.Max.X {
+			return
+		}
+		if x > inner.Min.X {
+			x += len(separator)
+		}
+		screen.PutStrStyled(x, y, k, keyStyle)
+		x += ansi.StringWidth(k) + 1
+		screen.PutStrStyled(x, y, b.Description, descStyle)
+		x += ansi.StringWidth(b.Description)
+	}
+}
+
+// hints returns the winning, described bindings from the supplied
+// ResolvedKeyBindings, most specific scope first.
+func hints(bindings []types.ResolvedKeyBinding) []types.ResolvedKeyBinding {
+	out := []types.ResolvedKeyBinding{}
+	for _, b := range bindings {
+		if b.Wins() && b.Description != "" {
+			out = append(out, b)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return rank(out[i].Source) < rank(out[j].Source)
+	})
+	return out
+}
+
+// rank returns the position of hints from the supplied KeyBindingSource.
+func rank(src types.KeyBindingSource) int {
+	switch src {
+	case types.KeyBindingSourceInterceptor, types.KeyBindingSourceFocused:
+		return 0
+	case types.KeyBindingSourceViewKeymap, types.KeyBindingSourceView,
+		types.KeyBindingSourceDescendant:
+		return 1
+	case types.KeyBindingSourceApplication,
+		types.KeyBindingSourceApplicationKeymap,
+		types.KeyBindingSourceViewActiveKey:
+		return 2
+	case types.KeyBindingSourceHelp:
+		return 3
+	}
+	return 4
+}
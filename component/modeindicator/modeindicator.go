@@ -0,0 +1,68 @@
+package modeindicator
+
+import (
+	"context"
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/jaypipes/gt/core"
+	gtlog "github.com/jaypipes/gt/core/log"
+	"github.com/jaypipes/gt/core/style"
+	"github.com/jaypipes/gt/element"
+	"github.com/jaypipes/gt/types"
+)
+
+const (
+	ElementClass = "gt.modeindicator"
+)
+
+// New returns a new ModeIndicator instance with the given options.
+func New(
+	ctx context.Context,
+	opts ...types.ElementWithOption,
+) *ModeIndicator {
+	e := element.New(ctx, ElementClass)
+	m := &ModeIndicator{Element: e}
+	// A ModeIndicator defaults to a single line the width of the parent
+	// container, like vim's "-- INSERT --" status line.
+	m.SetDisplay(types.DisplayBlock)
+	m.SetHeight(core.Fixed(1))
+	m.SetWhitespace(types.WhitespaceWrapNever)
+	m.SetThemeClass(types.ThemeClassNavigation)
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// ModeIndicator is a Component that renders the name of the current input
+// Mode in upper case, e.g. " NORMAL ", and nothing when no Mode is set.
+//
+// The Mode is read from the ScreenHandler, typically the Application, every
+// time the ModeIndicator is rendered, so it follows changes of Mode.
+type ModeIndicator struct {
+	element.Element
+}
+
+// Render implements the types.Renderable interface
+func (m *ModeIndicator) Render(
+	ctx context.Context,
+	handler types.ScreenHandler,
+) {
+	gtlog.Debug(ctx, "ModeIndicator.Render[%s]: bounds=%s", m.Tag(), m.Bounds())
+	m.RenderBox(ctx, handler)
+	p, ok := handler.(types.ModeProvider)
+	if !ok {
+		return
+	}
+	mode := p.CurrentMode()
+	if mode == nil {
+		return
+	}
+	text := " " + strings.ToUpper(mode.Name()) + " "
+	inner := m.InnerBounds()
+	text = ansi.Truncate(text, inner.Dx(), "")
+	s := style.TCell(m.Style()).Reverse(true)
+	handler.Screen().PutStrStyled(inner.Min.X, inner.Min.Y, text, s)
+}
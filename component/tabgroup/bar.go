@@ -31,6 +31,7 @@ func defaultBar(ctx context.Context, group *TabGroup) *Bar {
 	d.SetHeight(core.Fixed(5))
 	d.SetPadding(types.PadHorizontal(2))
 	d.SetBorder(DefaultBarBorder)
+	d.SetThemeClass(types.ThemeClassNavigation)
 	return &Bar{
 		Div:               *d,
 		group:             group,
@@ -116,6 +117,8 @@ func (b *Bar) Build(ctx context.Context) {
 			element.WithPadding(b.tabPadding),
 			element.WithDisplay(types.DisplayInlineBlock),
 			element.WithWidth(core.Fixed(12)),
+			element.WithThemeClass(types.ThemeClassNavigation),
+			element.WithSelected(x == b.group.activeTab),
 		)
 		if x == b.group.activeTab {
 			tabEl.SetBorder(b.tabActiveBorder)
@@ -109,7 +109,7 @@ func (g *TabGroup) SetKeyShortcut(shortcut types.KeyShortcut) {
 		if ksk.Equal(k) {
 			gtlog.Warn(
 				context.TODO(),
-				"key shortcut %q shadows previously-registered "+
+				"key shortcut %q is shadowed by previously-registered "+
 					"tabgroup-level key shortcut",
 				k,
 			)
@@ -118,6 +118,17 @@ func (g *TabGroup) SetKeyShortcut(shortcut types.KeyShortcut) {
 	g.keyShortcuts = append(g.keyShortcuts, shortcut)
 }
 
+// KeyShortcuts returns the TabGroup-level KeyShortcuts.
+func (g *TabGroup) KeyShortcuts() []types.KeyShortcut {
+	return g.keyShortcuts
+}
+
+// ViewKeyShortcuts returns the TabGroup-level KeyShortcuts, which are active
+// whenever the View containing the TabGroup is active.
+func (g *TabGroup) ViewKeyShortcuts() []types.KeyShortcut {
+	return g.keyShortcuts
+}
+
 // Build constructs the tab bar and tab content elements.
 func (g *TabGroup) Build(
 	ctx context.Context,
@@ -139,3 +150,5 @@ func (g *TabGroup) Build(
 	}
 	g.rebuild = false
 }
+
+var _ types.ViewKeyShortcutHandler = (*TabGroup)(nil)
@@ -0,0 +1,41 @@
+package action
+
+import "github.com/jaypipes/gt/types"
+
+// Action is a named operation that a Keymap binds keys to.
+type Action struct {
+	// name is the Action's unique name.
+	name string
+	// description is a short, human-readable description of the Action.
+	description string
+	// callback is executed when the Action is triggered.
+	callback types.ActionCallback
+}
+
+// Name returns the Action's unique name.
+func (a *Action) Name() string {
+	return a.name
+}
+
+// Description returns a short, human-readable description of what the Action
+// does.
+func (a *Action) Description() string {
+	return a.description
+}
+
+// SetDescription sets the Action's description.
+func (a *Action) SetDescription(description string) {
+	a.description = description
+}
+
+// Callback returns the ActionCallback executed when the Action is triggered.
+func (a *Action) Callback() types.ActionCallback {
+	return a.callback
+}
+
+// SetCallback sets the ActionCallback executed when the Action is triggered.
+func (a *Action) SetCallback(cb types.ActionCallback) {
+	a.callback = cb
+}
+
+var _ types.Action = (*Action)(nil)
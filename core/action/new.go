@@ -0,0 +1,30 @@
+package action
+
+import "github.com/jaypipes/gt/types"
+
+// New returns a new Action with the supplied name.
+//
+// You can pass zero or more ActionWithOptions to optionally set certain
+// attributes on the returned Action.
+func New(name string, opts ...types.ActionWithOption) *Action {
+	a := &Action{name: name}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// WithDescription sets the Action's description.
+func WithDescription(description string) types.ActionWithOption {
+	return func(a types.Action) {
+		a.SetDescription(description)
+	}
+}
+
+// WithCallback sets the ActionCallback executed when the Action is
+// triggered.
+func WithCallback(cb types.ActionCallback) types.ActionWithOption {
+	return func(a types.Action) {
+		a.SetCallback(cb)
+	}
+}
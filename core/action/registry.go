@@ -0,0 +1,57 @@
+package action
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/jaypipes/gt/types"
+)
+
+// Registry is a set of Actions looked up by name.
+type Registry struct {
+	sync.RWMutex
+	// actions maps Action names to Actions.
+	actions map[string]types.Action
+}
+
+// NewRegistry returns a new, empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{actions: map[string]types.Action{}}
+}
+
+// RegisterAction registers the supplied Action, replacing any Action already
+// registered with the same name.
+func (r *Registry) RegisterAction(a types.Action) {
+	if a == nil {
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+	if r.actions == nil {
+		r.actions = map[string]types.Action{}
+	}
+	r.actions[a.Name()] = a
+}
+
+// Action returns the Action registered with the supplied name, or nil.
+func (r *Registry) Action(name string) types.Action {
+	r.RLock()
+	defer r.RUnlock()
+	return r.actions[name]
+}
+
+// Actions returns all registered Actions, sorted by name.
+func (r *Registry) Actions() []types.Action {
+	r.RLock()
+	defer r.RUnlock()
+	out := make([]types.Action, 0, len(r.actions))
+	for _, a := range r.actions {
+		out = append(out, a)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Name() < out[j].Name()
+	})
+	return out
+}
+
+var _ types.ActionRegistry = (*Registry)(nil)
@@ -11,13 +11,18 @@ import (
 
 	"github.com/gdamore/tcell/v3"
 
+	"github.com/jaypipes/gt/core/action"
 	"github.com/jaypipes/gt/core/box"
+	gtcontext "github.com/jaypipes/gt/core/context"
 	"github.com/jaypipes/gt/core/cursor"
 	kpevent "github.com/jaypipes/gt/core/event/keypress"
 	mevent "github.com/jaypipes/gt/core/event/mouse"
 	sevent "github.com/jaypipes/gt/core/event/scroll"
 	"github.com/jaypipes/gt/core/key"
 	gtlog "github.com/jaypipes/gt/core/log"
+	"github.com/jaypipes/gt/core/palette"
+	"github.com/jaypipes/gt/core/render"
+	"github.com/jaypipes/gt/core/theme"
 	"github.com/jaypipes/gt/core/view"
 	"github.com/jaypipes/gt/types"
 )
@@ -27,6 +32,10 @@ const (
 	defaultDrawMinInterval = 50 * time.Millisecond
 )
 
+// redraw is the payload of the interrupt event that Redraw posts to the
+// Screen's event queue.
+type redraw struct{}
+
 var (
 	defaultExitKey      = key.New("ctrl+c")
 	defaultFocusNextKey = key.New("tab")
@@ -36,6 +45,9 @@ var (
 func New(
 	ctx context.Context,
 ) *Application {
+	// The terminal must be queried for its background color before the
+	// Screen takes over the terminal's input.
+	dark := palette.DetectDarkBackground()
 	s, err := tcell.NewScreen()
 	if err != nil {
 		log.Fatalf("%+v", err)
@@ -44,12 +56,32 @@ func New(
 	if err != nil {
 		log.Fatalf("%+v", err)
 	}
+	a := NewWithScreen(ctx, s)
+	a.darkBackground = dark
+	return a
+}
+
+// NewWithScreen returns a new Application drawing to the supplied, already
+// initialized Screen, e.g. a headless Screen for replaying a recorded
+// session. The terminal's background is assumed to be dark unless a replayed
+// session recorded it.
+func NewWithScreen(
+	ctx context.Context,
+	s tcell.Screen,
+) *Application {
+	// Colors are mapped to the nearest colors supported by the context's
+	// color profile, or the profile detected from the environs if the
+	// context has none, as they are drawn.
+	s = withColorProfile(s, gtcontext.ColorProfile(ctx))
 	return &Application{
-		screen:        s,
-		cursor:        cursor.New(cursor.WithScreen(s)), // default is hidden cursor
-		exitKeys:      []types.Key{defaultExitKey},
-		focusNextKeys: []types.Key{defaultFocusNextKey},
-		views:         map[string]types.View{},
+		screen:         s,
+		cursor:         cursor.New(cursor.WithScreen(s)), // default is hidden cursor
+		exitKeys:       []types.Key{defaultExitKey},
+		focusNextKeys:  []types.Key{defaultFocusNextKey},
+		views:          map[string]types.View{},
+		actions:        action.NewRegistry(),
+		darkBackground: true,
+		done:           make(chan struct{}),
 	}
 }
 
@@ -75,6 +107,16 @@ type Application struct {
 	// terminal when set.
 	title string
 
+	// darkBackground is true if the terminal was detected to have a dark
+	// background when the Application was created.
+	darkBackground bool
+	// theme is the Theme used for any Elements that do not have a Theme of
+	// their own and are not contained in an Element or View having a Theme.
+	theme types.Theme
+	// stylesheet is the Stylesheet whose Rules apply to all Elements in all
+	// of the Application's Views.
+	stylesheet types.Stylesheet
+
 	// views is a map, keyed by the View ID, of Views that the Application is
 	// managing.
 	views map[string]types.View
@@ -94,12 +136,48 @@ type Application struct {
 	// keyShortcuts contains key press combination callbacks registered for the
 	// Application itself -- i.e. global key press callbacks.
 	keyShortcuts []types.KeyShortcut
+	// actions contains the named Actions that the keymap binds keys to.
+	actions *action.Registry
+	// keymap maps keys to the names of Actions, per KeymapScope.
+	keymap types.Keymap
+	// modes is a map, keyed by name, of the registered input Modes.
+	modes map[string]types.Mode
+	// mode is the current input Mode, or nil.
+	mode types.Mode
+	// modeBeforeInsert is the Mode that was current before the Application
+	// switched to an insert-like Mode when key presses were intercepted.
+	modeBeforeInsert types.Mode
+	// autoInsert is true if the Application switched to an insert-like Mode
+	// when key presses were intercepted.
+	autoInsert bool
+	// onModeChange contains the callbacks executed when the Mode changes.
+	onModeChange []types.ModeChangeCallback
 	// keyInterceptor points to a types.KeyPressEventHandler that receives all
 	// keyboard input after InterceptKey has been called.
 	keyInterceptor types.KeyPressEventHandler
 	// keyInterceptEscape is the key combination that will trigger the
 	// interceptor to be removed.
 	keyInterceptEscape types.Key
+	// keySequenceTimeout is how long to wait for the next key press
+	// combination of a multi-key sequence.
+	keySequenceTimeout time.Duration
+	// pendingKeys contains the key press combinations pressed so far of a
+	// multi-key sequence.
+	pendingKeys []types.Key
+	// pendingGen is incremented whenever the pending keys change so that the
+	// timeouts of earlier pending sequences are ignored.
+	pendingGen int
+	// pendingTimer fires when the timeout for the next key press combination
+	// of a multi-key sequence expires.
+	pendingTimer *time.Timer
+	// hidePendingKeys is true if the pending keys of a multi-key sequence
+	// should not be displayed.
+	hidePendingKeys bool
+	// helpKeys contains the keypress combinations that toggle the key binding
+	// help overlay. If nil, the defaults of "?" and "F1" are used.
+	helpKeys []types.Key
+	// showHelp is true if the key binding help overlay is displayed.
+	showHelp bool
 
 	// mouseEnabled is true if we're trapping mouse events in the terminal.
 	mouseEnabled bool
@@ -112,6 +190,9 @@ type Application struct {
 	focused types.FocusEventHandler
 	// hovered contains the thing that the mouse is currently over.
 	hovered types.MouseEventHandler
+	// pressed contains the thing that a mouse button is currently held down
+	// on.
+	pressed types.MouseEventHandler
 
 	// mouseDragged is true if a mouse button was pressed and the mouse moved.
 	mouseDragged bool
@@ -119,6 +200,13 @@ type Application struct {
 	lastMouseClickTime time.Time
 	// mouseDownEvent stores the event when the user pressed a mouse button.
 	mouseDownEvent types.MouseEvent
+
+	// queueMu guards posting events to the Screen's event queue against the
+	// queue being closed when the Application exits.
+	queueMu sync.RWMutex
+	// done is closed once the event loop has stopped, before the Screen is
+	// finalized. No events are posted to the Screen's event queue after that.
+	done chan struct{}
 }
 
 // Title returns the Application's optional title.
@@ -132,6 +220,90 @@ func (a *Application) SetTitle(title string) {
 	a.title = title
 }
 
+// DarkBackground returns true if the terminal was detected to have a dark
+// background when the Application was created.
+func (a *Application) DarkBackground() bool {
+	return a.darkBackground
+}
+
+// Theme returns the Application's Theme, if any.
+func (a *Application) Theme() types.Theme {
+	return a.theme
+}
+
+// SetTheme sets the Application's Theme. The Theme applies to all Elements in
+// the Application's Views that do not have a Theme of their own and are not
+// contained in an Element or View having a Theme.
+//
+// SetTheme may be called while the Application is running, for instance from
+// a key shortcut callback. Since the new Theme's Borders may take up more or
+// less space than the old Theme's, the layout of all Views is invalidated and
+// the active View is redrawn.
+func (a *Application) SetTheme(t types.Theme) {
+	a.theme = t
+	ctx := context.Background()
+	for _, v := range a.Views() {
+		if n, ok := v.(types.Node); ok {
+			render.Invalidate(ctx, n)
+		}
+	}
+	a.Redraw()
+}
+
+// Redraw schedules the Application's active View to be redrawn by the
+// Application's event loop. Redraw is safe to call from any goroutine.
+//
+// Redraw does nothing once the Application has exited.
+func (a *Application) Redraw() {
+	// If the event queue is full, the event loop will draw soon anyway.
+	a.postEvent(tcell.NewEventInterrupt(redraw{}))
+}
+
+// postEvent posts the supplied event to the Screen's event queue without
+// blocking. It returns false if the queue is full or the Application has
+// exited.
+func (a *Application) postEvent(ev tcell.Event) bool {
+	a.queueMu.RLock()
+	defer a.queueMu.RUnlock()
+	select {
+	case <-a.done:
+		return false
+	default:
+	}
+	select {
+	case a.screen.EventQ() <- ev:
+		return true
+	default:
+		return false
+	}
+}
+
+// stopPosting marks the event loop as stopped so that no further events are
+// posted to the Screen's event queue. It must be called before the Screen is
+// finalized, which closes the queue.
+func (a *Application) stopPosting() {
+	a.queueMu.Lock()
+	defer a.queueMu.Unlock()
+	select {
+	case <-a.done:
+	default:
+		close(a.done)
+	}
+}
+
+// Stylesheet returns the Application's Stylesheet, if any.
+func (a *Application) Stylesheet() types.Stylesheet {
+	return a.stylesheet
+}
+
+// SetStylesheet sets the Application's Stylesheet. The Stylesheet's Rules
+// apply to all Elements in all of the Application's Views. Rules in a View's
+// own Stylesheet take precedence over Rules of equal specificity in the
+// Application's Stylesheet.
+func (a *Application) SetStylesheet(s types.Stylesheet) {
+	a.stylesheet = s
+}
+
 // EnableMouse enables mouse event handling for the Application.
 func (a *Application) EnableMouse() {
 	a.mouseEnabled = true
@@ -242,8 +414,19 @@ func (a *Application) Start(ctx context.Context) error {
 
 	s.Clear()
 
+	// The replay goroutine, if any, is stopped and joined before the Screen
+	// is finalized, which closes the event queue it posts to.
+	replayCtx, stopReplay := context.WithCancel(ctx)
+	var replayWG sync.WaitGroup
+
 	quit := func() {
 		maybePanic := recover()
+		stopReplay()
+		replayWG.Wait()
+		a.Lock()
+		a.stopPendingTimer()
+		a.Unlock()
+		a.stopPosting()
 		if s != nil {
 			s.Fini()
 		}
@@ -256,11 +439,55 @@ func (a *Application) Start(ctx context.Context) error {
 	}
 	defer quit()
 
+	rec, err := a.startRecording(ctx)
+	if err != nil {
+		return err
+	}
+	if rec != nil {
+		defer rec.Close()
+	}
+	replaying, advance, err := a.startReplay(replayCtx, &replayWG)
+	if err != nil {
+		return err
+	}
+
+	// If the user has not set a Theme for the Application, we select the
+	// named (or default) Theme variant matching the terminal's background,
+	// or the background recorded in a replayed session.
+	if a.theme == nil {
+		a.theme = theme.Select(gtcontext.ThemeName(ctx), a.darkBackground)
+	}
+
 	a.draw(ctx)
 
 loop:
 	for {
 		ev := <-s.EventQ()
+		if replayed, ok := replayedEvent(ev); ok {
+			ev = replayed
+		} else if replaying && isInputEvent(ev) {
+			// While replaying a session, input from the terminal only
+			// advances a stepped replay or exits, though the Screen still
+			// follows the terminal's size.
+			if _, ok := ev.(*tcell.EventResize); ok {
+				s.Sync()
+			}
+			if kev, ok := ev.(*tcell.EventKey); ok {
+				if a.exitKeyPressed(kpevent.New(kpevent.WithTCell(kev))) {
+					break loop
+				}
+				select {
+				case advance <- struct{}{}:
+				default:
+				}
+			}
+			continue
+		}
+		if rec != nil {
+			if err := rec.Record(ev); err != nil {
+				gtlog.Warn(ctx, "Application.Start: failed to record event: %s", err)
+			}
+		}
 		switch ev := ev.(type) {
 		case *tcell.EventResize:
 			s.Sync()
@@ -278,6 +505,17 @@ loop:
 				mev := mevent.New(mevent.WithTCell(ev))
 				a.handleMouseEvent(ctx, mev)
 			}
+		case *tcell.EventInterrupt:
+			switch data := ev.Data().(type) {
+			case redraw:
+				a.draw(ctx)
+			case keySequenceTimeout:
+				a.keySequenceTimedOut(ctx, data.gen)
+			case replayDone:
+				if gtcontext.ReplayExit(ctx) {
+					break loop
+				}
+			}
 		case *tcell.EventError:
 			return ev
 		}
@@ -297,6 +535,8 @@ func (a *Application) draw(ctx context.Context) {
 	v := a.ActiveView()
 	if v == nil {
 		v = view.New(ctx, view.WithID("main"))
+		v.SetThemeProvider(a)
+		v.SetStylesheetProvider(a)
 		a.views["main"] = v
 		a.activeView = "main"
 	}
@@ -307,5 +547,7 @@ func (a *Application) draw(ctx context.Context) {
 	a.Box.Render(ctx, a)
 	v.SetBounds(a.InnerBounds())
 	v.Draw(ctx, a)
+	a.drawHelp(ctx)
+	a.drawPendingKeys()
 	s.Show()
 }
@@ -0,0 +1,248 @@
+package application
+
+import (
+	"context"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/gdamore/tcell/v3"
+
+	"github.com/jaypipes/gt/core/border"
+	"github.com/jaypipes/gt/core/box"
+	"github.com/jaypipes/gt/core/key"
+	"github.com/jaypipes/gt/core/style"
+	"github.com/jaypipes/gt/types"
+)
+
+var (
+	defaultHelpKeys = []types.Key{key.New("?"), key.New("f1")}
+)
+
+// helpGroup is a column of the key binding help overlay.
+type helpGroup struct {
+	// title is the column's heading.
+	title string
+	// rows contains the key and description of each binding in the column.
+	rows [][2]string
+}
+
+// SetHelpKey configures the keypress combinations that toggle the key binding
+// help overlay, replacing the defaults of "?" and "F1". Calling SetHelpKey
+// with no keypress combinations disables toggling the overlay by key.
+//
+// The help keys are only offered a key press that nothing else handles, so
+// an Element or KeyShortcut using "?" keeps working.
+func (a *Application) SetHelpKey(subject ...any) {
+	a.Lock()
+	defer a.Unlock()
+	a.helpKeys = []types.Key{}
+	for _, s := range subject {
+		a.helpKeys = append(a.helpKeys, key.New(s))
+	}
+}
+
+// HelpKeys returns the keypress combinations that toggle the key binding help
+// overlay.
+func (a *Application) HelpKeys() []types.Key {
+	a.RLock()
+	defer a.RUnlock()
+	if a.helpKeys == nil {
+		return defaultHelpKeys
+	}
+	return a.helpKeys
+}
+
+// ShowHelp sets whether the key binding help overlay is displayed over the
+// active View.
+//
+// The overlay lists the described key bindings that currently win, grouped
+// into columns for the Application, the active View and the focused Element.
+// It is rebuilt on every draw, so it follows changes to the focus.
+func (a *Application) ShowHelp(ctx context.Context, show bool) {
+	a.Lock()
+	changed := a.showHelp != show
+	a.showHelp = show
+	a.Unlock()
+	if changed && a.screen != nil {
+		a.draw(ctx)
+	}
+}
+
+// HelpShown returns true if the key binding help overlay is displayed.
+func (a *Application) HelpShown() bool {
+	a.RLock()
+	defer a.RUnlock()
+	return a.showHelp
+}
+
+// helpKeyPressed returns true if the supplied KeyPressEvent matches any of the
+// help keys.
+func (a *Application) helpKeyPressed(ev types.KeyPressEvent) bool {
+	for _, hk := range a.HelpKeys() {
+		if hk.Equal(ev.Key()) {
+			return true
+		}
+	}
+	return false
+}
+
+// helpGroups returns the columns of the key binding help overlay.
+func (a *Application) helpGroups() []helpGroup {
+	app := helpGroup{title: "Application"}
+	view := helpGroup{title: "View"}
+	focused := helpGroup{title: "Focused"}
+	if v := a.ActiveView(); v != nil && v.ID() != "" {
+		view.title = "View " + v.ID()
+	}
+	for _, b := range a.KeyBindings() {
+		if !b.Wins() {
+			continue
+		}
+		desc := b.Description
+		if desc == "" {
+			desc = b.Action
+		}
+		row := [2]string{b.Key.String(), desc}
+		switch b.Source {
+		case types.KeyBindingSourceInterceptor,
+			types.KeyBindingSourceFocused:
+			focused.rows = append(focused.rows, row)
+		case types.KeyBindingSourceViewKeymap,
+			types.KeyBindingSourceView,
+			types.KeyBindingSourceDescendant:
+			view.rows = append(view.rows, row)
+		default:
+			app.rows = append(app.rows, row)
+		}
+	}
+	groups := []helpGroup{}
+	for _, g := range []helpGroup{app, view, focused} {
+		if len(g.rows) > 0 {
+			groups = append(groups, g)
+		}
+	}
+	return groups
+}
+
+// drawHelp draws the key binding help overlay centered within the
+// Application's inner bounds, laying the groups out in columns that wrap
+// onto further bands when they do not fit side by side.
+func (a *Application) drawHelp(ctx context.Context) {
+	if !a.HelpShown() {
+		return
+	}
+	const gap = 3
+	groups := a.helpGroups()
+	inner := a.InnerBounds()
+	maxW := inner.Dx() - 4
+
+	// lay out the columns, measuring each one.
+	type column struct {
+		group   helpGroup
+		keyW, w int
+		x, y    int
+	}
+	cols := []*column{}
+	x, y, bandH, width := 0, 0, 0, 0
+	for _, g := range groups {
+		c := &column{group: g, w: ansi.StringWidth(g.title)}
+		for _, r := range g.rows {
+			c.keyW = max(c.keyW, ansi.StringWidth(r[0]))
+		}
+		for _, r := range g.rows {
+			c.w = max(c.w, c.keyW+2+ansi.StringWidth(r[1]))
+		}
+		if x > 0 && x+c.w > maxW {
+			x, y, bandH = 0, y+bandH+1, 0
+		}
+		c.x, c.y = x, y
+		x += c.w + gap
+		width = max(width, x-gap)
+		bandH = max(bandH, len(g.rows)+1)
+		cols = append(cols, c)
+	}
+	height := y + bandH
+	if len(cols) == 0 {
+		width, height = ansi.StringWidth("no key bindings"), 1
+	}
+
+	// center the box, including its border and horizontal padding.
+	boxW := min(width+4, inner.Dx())
+	boxH := min(height+2, inner.Dy())
+	left := inner.Min.X + (inner.Dx()-boxW)/2
+	top := inner.Min.Y + (inner.Dy()-boxH)/2
+	right := left + boxW - 1
+	bottom := top + boxH - 1
+
+	s := a.screen
+	bs, bb := a.helpStyle()
+	st := style.TCell(bs)
+	for row := top + 1; row < bottom; row++ {
+		for col := left + 1; col < right; col++ {
+			s.SetContent(col, row, ' ', nil, st)
+		}
+	}
+	frame := box.New(ctx)
+	frame.SetBounds(types.Rect(left, top, right+1, bottom+1))
+	frame.SetBorder(bb)
+	frame.SetBorderTitle(border.NewLabel(
+		"Keys",
+		border.WithLabelDelimiters(" ", " "),
+		border.WithLabelStyle(style.Clone(bs).WithBold(true)),
+	))
+	frame.Render(ctx, a)
+
+	put := func(cx, cy int, text string, style tcell.Style, w int) {
+		if cy <= top || cy >= bottom || cx >= right-1 {
+			return
+		}
+		text = ansi.Truncate(text, min(w, right-1-cx), "…")
+		s.PutStrStyled(cx, cy, text, style)
+	}
+	if len(cols) == 0 {
+		put(left+2, top+1, "no key bindings", st.Dim(true), width)
+	}
+	for _, c := range cols {
+		cx, cy := left+2+c.x, top+1+c.y
+		put(cx, cy, c.group.title, st.Bold(true).Underline(true), c.w)
+		for i, r := range c.group.rows {
+			put(cx, cy+1+i, r[0], st.Bold(true), c.keyW)
+			put(cx+c.keyW+2, cy+1+i, r[1], st, c.w-c.keyW-2)
+		}
+	}
+}
+
+// helpStyle returns the Style and Border of the key binding help overlay.
+// They are taken from the Application's Theme for types.ThemeClassHelp,
+// falling back to types.ThemeClassPrimary and then to an unstyled normal
+// border.
+func (a *Application) helpStyle() (types.Style, types.Border) {
+	var st types.Style
+	var b types.Border
+	if t := a.Theme(); t != nil {
+		for _, class := range []types.ThemeClass{
+			types.ThemeClassHelp, types.ThemeClassPrimary,
+		} {
+			if m := t.Motif(class); m != nil {
+				if s := m.NormalStyle(); st == nil && s != nil && !s.Unstyled() {
+					st = s
+				}
+				if b == nil {
+					b = m.NormalBorder()
+				}
+			}
+			if s := t.Style(class); st == nil && s != nil && !s.Unstyled() {
+				st = s
+			}
+			if b == nil {
+				b = t.Border(class)
+			}
+		}
+	}
+	if st == nil {
+		st = style.Empty()
+	}
+	if b == nil {
+		b = border.Normal()
+	}
+	return st, b
+}
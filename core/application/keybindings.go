@@ -0,0 +1,198 @@
+package application
+
+import (
+	"sort"
+
+	"github.com/jaypipes/gt/core"
+	"github.com/jaypipes/gt/core/keymap"
+	"github.com/jaypipes/gt/types"
+)
+
+// KeyBindings returns every key binding currently active in the Application,
+// across the Application, the active View, the focused Element and any
+// KeyPressEventHandler intercepting key presses, in the order the
+// Application offers a key press to them.
+//
+// Each ResolvedKeyBinding says whether it wins or which binding shadows it.
+func (a *Application) KeyBindings() []types.ResolvedKeyBinding {
+	a.RLock()
+	exitKeys := a.exitKeys
+	focusNextKeys := a.focusNextKeys
+	keyShortcuts := a.keyShortcuts
+	interceptor := a.keyInterceptor
+	escapeKey := a.keyInterceptEscape
+	focused := a.focused
+	views := a.views
+	activeView := a.ActiveView()
+	a.RUnlock()
+
+	out := []types.ResolvedKeyBinding{}
+	add := func(
+		k types.Key, src types.KeyBindingSource, owner, desc string,
+	) {
+		if k == nil {
+			return
+		}
+		out = append(out, types.ResolvedKeyBinding{
+			Key:         k,
+			Source:      src,
+			Owner:       owner,
+			Description: desc,
+		})
+	}
+	addShortcuts := func(
+		src types.KeyBindingSource, owner string, kss []types.KeyShortcut,
+	) {
+		for _, ks := range kss {
+			add(ks.Key(), src, owner, ks.Description())
+		}
+	}
+	addKeymap := func(
+		src types.KeyBindingSource, owner string, scope types.KeymapScope,
+	) {
+		current := a.CurrentMode()
+		for x, km := range a.keymaps() {
+			mode := ""
+			if x == 0 && current != nil && current.Keymap() == km {
+				mode = current.Name()
+			}
+			for _, b := range km.Bindings() {
+				if b.Scope != scope || b.Action == "" {
+					continue
+				}
+				desc := ""
+				if act := a.Action(b.Action); act != nil {
+					desc = act.Description()
+				}
+				out = append(out, types.ResolvedKeyBinding{
+					Key:         b.Key,
+					Source:      src,
+					Owner:       owner,
+					Description: desc,
+					Action:      b.Action,
+					Mode:        mode,
+				})
+			}
+		}
+	}
+
+	for _, k := range exitKeys {
+		add(k, types.KeyBindingSourceExit, "", "exit")
+	}
+	if interceptor != nil {
+		add(
+			escapeKey, types.KeyBindingSourceInterceptor,
+			core.ID(interceptor), "stop intercepting key presses",
+		)
+	}
+	for _, k := range focusNextKeys {
+		add(k, types.KeyBindingSourceFocusNext, "", "focus next")
+	}
+	for _, el := range focusChain(focused) {
+		addShortcuts(
+			types.KeyBindingSourceFocused, core.ID(el),
+			elementKeyShortcuts(el),
+		)
+		addKeymap(
+			types.KeyBindingSourceFocused, el.Class(),
+			keymap.Class(el.Class()),
+		)
+	}
+	if activeView != nil {
+		addKeymap(
+			types.KeyBindingSourceViewKeymap, activeView.ID(),
+			keymap.View(activeView.ID()),
+		)
+		addShortcuts(
+			types.KeyBindingSourceView, activeView.ID(),
+			activeView.KeyShortcuts(),
+		)
+	}
+	addShortcuts(types.KeyBindingSourceApplication, "", keyShortcuts)
+	addKeymap(
+		types.KeyBindingSourceApplicationKeymap, "", keymap.Application(),
+	)
+	for _, id := range sortedViewIDs(views) {
+		if activeView != nil && id == activeView.ID() {
+			continue
+		}
+		add(
+			views[id].ActiveKey(), types.KeyBindingSourceViewActiveKey,
+			id, "switch to view "+id,
+		)
+	}
+	if activeView != nil {
+		var walk func(subject any)
+		walk = func(subject any) {
+			n, ok := subject.(types.Node)
+			if !ok {
+				return
+			}
+			for _, child := range n.Children() {
+				if h, ok := child.(types.ViewKeyShortcutHandler); ok {
+					addShortcuts(
+						types.KeyBindingSourceDescendant, core.ID(child),
+						h.ViewKeyShortcuts(),
+					)
+				}
+				walk(child)
+			}
+		}
+		walk(activeView)
+	}
+	for _, k := range a.HelpKeys() {
+		add(k, types.KeyBindingSourceHelp, "", "help")
+	}
+
+	// The first binding of a Key wins over every later binding of it, except
+	// that an interceptor wins over everything but the exit keys.
+	var intercepting *types.ResolvedKeyBinding
+	for x := range out {
+		b := &out[x]
+		if b.Source == types.KeyBindingSourceInterceptor {
+			intercepting = b
+		}
+		for y := 0; y < x; y++ {
+			if out[y].Key.Equal(b.Key) {
+				b.ShadowedBy = &out[y]
+				break
+			}
+		}
+		if b.ShadowedBy == nil && intercepting != nil && b != intercepting {
+			b.ShadowedBy = intercepting
+		}
+	}
+	return out
+}
+
+// KeyBindingConflicts returns a KeyBindingConflict for every Key that is
+// bound more than once in the Application's current KeyBindings.
+func (a *Application) KeyBindingConflicts() []types.KeyBindingConflict {
+	bindings := a.KeyBindings()
+	conflicts := []types.KeyBindingConflict{}
+	for x, b := range bindings {
+		if b.ShadowedBy != nil && b.ShadowedBy.Key.Equal(b.Key) {
+			continue
+		}
+		c := types.KeyBindingConflict{Key: b.Key, Winner: b}
+		for _, other := range bindings[x+1:] {
+			if other.Key.Equal(b.Key) {
+				c.Shadowed = append(c.Shadowed, other)
+			}
+		}
+		if len(c.Shadowed) > 0 {
+			conflicts = append(conflicts, c)
+		}
+	}
+	return conflicts
+}
+
+// sortedViewIDs returns the IDs of the supplied Views in sorted order.
+func sortedViewIDs(views map[string]types.View) []string {
+	ids := make([]string, 0, len(views))
+	for id := range views {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,121 @@
+package application
+
+import (
+	"context"
+
+	"github.com/jaypipes/gt/core/action"
+	"github.com/jaypipes/gt/core/keymap"
+	gtlog "github.com/jaypipes/gt/core/log"
+	"github.com/jaypipes/gt/types"
+)
+
+// RegisterAction registers a named Action that the Application's Keymap can
+// bind keys to.
+func (a *Application) RegisterAction(act types.Action) {
+	a.Lock()
+	if a.actions == nil {
+		a.actions = action.NewRegistry()
+	}
+	actions := a.actions
+	a.Unlock()
+	actions.RegisterAction(act)
+}
+
+// Action returns the registered Action with the supplied name, or nil.
+func (a *Application) Action(name string) types.Action {
+	a.RLock()
+	defer a.RUnlock()
+	if a.actions == nil {
+		return nil
+	}
+	return a.actions.Action(name)
+}
+
+// Actions returns all registered Actions, sorted by name.
+func (a *Application) Actions() []types.Action {
+	a.RLock()
+	defer a.RUnlock()
+	if a.actions == nil {
+		return nil
+	}
+	return a.actions.Actions()
+}
+
+// SetKeymap sets the Keymap binding keys to the names of registered Actions.
+// Any conflicts found in the Keymap, such as bindings to unknown Actions, are
+// logged as warnings.
+func (a *Application) SetKeymap(ctx context.Context, km types.Keymap) {
+	a.Lock()
+	a.keymap = km
+	a.Unlock()
+	if km == nil {
+		return
+	}
+	for _, c := range keymap.Check(km, a) {
+		gtlog.Warn(ctx, "Application.SetKeymap: %s", c)
+	}
+}
+
+// Keymap returns the Application's Keymap, or nil.
+func (a *Application) Keymap() types.Keymap {
+	a.RLock()
+	defer a.RUnlock()
+	return a.keymap
+}
+
+// triggerAction looks up the supplied Key within the supplied KeymapScope in
+// the current Mode's Keymap and then the Application's Keymap and, if it is
+// bound to a registered Action with a callback, calls the callback with the
+// supplied target. It returns whether an Action was triggered.
+func (a *Application) triggerAction(
+	ctx context.Context,
+	scope types.KeymapScope,
+	k types.Key,
+	target any,
+) bool {
+	name, ok := "", false
+	for _, km := range a.keymaps() {
+		if name, ok = km.Lookup(scope, k); ok {
+			break
+		}
+	}
+	if !ok {
+		return false
+	}
+	act := a.Action(name)
+	if act == nil {
+		gtlog.Warn(ctx, "Application: %s: %q bound to unknown action %q",
+			scope, k, name)
+		return false
+	}
+	cb := act.Callback()
+	if cb == nil {
+		return false
+	}
+	gtlog.Debug(ctx, "Application: %s: %q triggered action %q", scope, k, name)
+	cb(ctx, target)
+	return true
+}
+
+// keymapKeys returns the Keys bound in the current Mode's Keymap and the
+// Application's Keymap within the Application scope, the scope of the
+// supplied active View and the class scopes of the focused Element and its
+// ancestors.
+func (a *Application) keymapKeys(activeView types.View, focused any) []types.Key {
+	scopes := map[types.KeymapScope]bool{keymap.Application(): true}
+	if activeView != nil {
+		scopes[keymap.View(activeView.ID())] = true
+	}
+	for _, el := range focusChain(focused) {
+		scopes[keymap.Class(el.Class())] = true
+	}
+	keys := []types.Key{}
+	for _, km := range a.keymaps() {
+		for _, b := range km.Bindings() {
+			if b.Action != "" && scopes[b.Scope] {
+				keys = append(keys, b.Key)
+			}
+		}
+	}
+	return keys
+}
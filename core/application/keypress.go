@@ -5,6 +5,7 @@ import (
 
 	"github.com/jaypipes/gt/core"
 	"github.com/jaypipes/gt/core/key"
+	"github.com/jaypipes/gt/core/keymap"
 	gtlog "github.com/jaypipes/gt/core/log"
 	"github.com/jaypipes/gt/types"
 )
@@ -50,13 +51,14 @@ func (a *Application) SetFocusNextKey(subject ...any) {
 // method allows elements to need to take input from the user when they have
 // the focus to prevent keyboard shortcuts from interfering with the input
 // stream.
+//
+// If an insert-like Mode is registered, the Application switches to it until
+// the key press events are no longer intercepted.
 func (a *Application) InterceptKeyPressEvents(
 	ctx context.Context,
 	escapeKey types.Key,
 	handler types.KeyPressEventHandler,
 ) {
-	a.Lock()
-	defer a.Unlock()
 	if handler == nil || escapeKey == nil {
 		return
 	}
@@ -65,8 +67,11 @@ func (a *Application) InterceptKeyPressEvents(
 		"Application.InterceptKeyPressEvents: to=%s escape=%q",
 		core.ID(handler), escapeKey,
 	)
+	a.Lock()
 	a.keyInterceptor = handler
 	a.keyInterceptEscape = escapeKey
+	a.Unlock()
+	a.enterInsertMode(ctx)
 }
 
 // StopInterceptKey signals the Application to restore the key map from
@@ -76,13 +81,15 @@ func (a *Application) StopInterceptKeyPressEvents(
 	ctx context.Context,
 ) {
 	a.Lock()
-	defer a.Unlock()
 	if a.keyInterceptor == nil {
+		a.Unlock()
 		return
 	}
 	gtlog.Debug(ctx, "Application.StopInterceptKeyPressEvents")
 	a.keyInterceptEscape = nil
 	a.keyInterceptor = nil
+	a.Unlock()
+	a.exitInsertMode(ctx)
 }
 
 // SetKeyShortcut registers an Application-level KeyShortcut that will execute
@@ -94,7 +101,7 @@ func (a *Application) SetKeyShortcut(shortcut types.KeyShortcut) {
 		if ksk.Equal(k) {
 			gtlog.Warn(
 				context.TODO(),
-				"key shortcut %q shadows previously-registered "+
+				"key shortcut %q is shadowed by previously-registered "+
 					"application-level key shortcut",
 				k,
 			)
@@ -103,6 +110,13 @@ func (a *Application) SetKeyShortcut(shortcut types.KeyShortcut) {
 	a.keyShortcuts = append(a.keyShortcuts, shortcut)
 }
 
+// KeyShortcuts returns the Application-level KeyShortcuts.
+func (a *Application) KeyShortcuts() []types.KeyShortcut {
+	a.RLock()
+	defer a.RUnlock()
+	return a.keyShortcuts
+}
+
 // exitKeyPressed returns true if the supplied KeyPressEvent matches any of the
 // exit keys registered for the Application.
 func (a *Application) exitKeyPressed(ev types.KeyPressEvent) bool {
@@ -135,9 +149,6 @@ func (a *Application) handleKeyPressEvent(
 	focused := a.focused
 	interceptor := a.keyInterceptor
 	escapeKey := a.keyInterceptEscape
-	keyShortcuts := a.keyShortcuts
-	views := a.views
-	activeView := a.ActiveView()
 	a.RUnlock()
 
 	k := ev.Key()
@@ -163,8 +174,42 @@ func (a *Application) handleKeyPressEvent(
 		return
 	}
 
+	// Key press combinations that start or continue a multi-key sequence are
+	// held until the sequence is complete, cancelled or times out.
+	ev, ok := a.sequenceKeyPressEvent(ctx, ev)
+	if !ok {
+		return
+	}
+	a.dispatchKeyPressEvent(ctx, ev)
+}
+
+// dispatchKeyPressEvent passes a KeyPressEvent, whose Key may be a complete
+// multi-key sequence, to the Application's focus keys, the KeyShortcuts and
+// Keymap class bindings of the focused element and its ancestors, the
+// Keymap's bindings for the active View, the active View's KeyShortcuts, the
+// Application's KeyShortcuts and Keymap, the Views' active keys, the focused
+// element, the active View and the elements it contains, and the help keys,
+// stopping at the first that handles it.
+func (a *Application) dispatchKeyPressEvent(
+	ctx context.Context,
+	ev types.KeyPressEvent,
+) {
+	a.RLock()
+	focused := a.focused
+	keyShortcuts := a.keyShortcuts
+	views := a.views
+	activeView := a.ActiveView()
+	a.RUnlock()
+
+	k := ev.Key()
 	handled := false
 
+	// Escape closes the key binding help overlay.
+	if a.HelpShown() && key.KeyEscape.Equal(k) {
+		a.ShowHelp(ctx, false)
+		return
+	}
+
 	// If our "move focus to next focusable" key press combination was pressed,
 	// let's move our focus.
 	if a.focusNextKeyPressed(ev) {
@@ -175,7 +220,32 @@ func (a *Application) handleKeyPressEvent(
 		}
 	}
 
-	// Next, we handle our Application-level global key shortcuts.
+	// Next, the KeyShortcuts and Keymap class bindings of the focused element
+	// and its ancestors, from the focused element outward, so that they win
+	// over View and Application bindings.
+	if a.focusChainKeyPress(ctx, focused, k) {
+		a.draw(ctx)
+		return
+	}
+
+	// Next, the Keymap's bindings for the active view and the active view's
+	// KeyShortcuts, so that they win over Application bindings.
+	activeViewID := activeView.ID()
+	if a.triggerAction(ctx, keymap.View(activeViewID), k, activeView) {
+		a.draw(ctx)
+		return
+	}
+	for _, ks := range activeView.KeyShortcuts() {
+		ksk := ks.Key()
+		if ksk.Equal(k) {
+			cb := ks.Callback()
+			cb(ctx)
+			a.draw(ctx)
+			return
+		}
+	}
+
+	// Then we handle our Application-level global key shortcuts.
 	for _, ks := range keyShortcuts {
 		ksk := ks.Key()
 		if ksk.Equal(k) {
@@ -185,9 +255,14 @@ func (a *Application) handleKeyPressEvent(
 		}
 	}
 
+	// Then the Keymap's Application-scope bindings of named Actions.
+	if a.triggerAction(ctx, keymap.Application(), k, a) {
+		a.draw(ctx)
+		return
+	}
+
 	// Then we check if the key press combination is a "switch active view"
 	// key, and if so, set the active view.
-	activeViewID := activeView.ID()
 	for viewID, v := range views {
 		if viewID == activeViewID {
 			continue
@@ -216,9 +291,72 @@ func (a *Application) handleKeyPressEvent(
 		}
 	}
 
-	// Finally, if nothing has handled the KeyPressEvent, we ask the active
-	// view to handle it.
+	// If nothing else has handled the KeyPressEvent, we ask the active view
+	// and the elements it contains to handle it.
 	if activeView.KeyPress(ctx, ev) {
 		a.draw(ctx)
+		return
+	}
+
+	// Finally, a help key toggles the key binding help overlay.
+	if a.helpKeyPressed(ev) {
+		a.ShowHelp(ctx, !a.HelpShown())
+	}
+}
+
+// focusChainKeyPress executes the KeyShortcut or triggers the Keymap class
+// binding matching the supplied Key on the supplied focused element or,
+// failing that, the closest of its ancestors having one. It returns whether
+// a binding was found.
+func (a *Application) focusChainKeyPress(
+	ctx context.Context,
+	focused any,
+	k types.Key,
+) bool {
+	for _, el := range focusChain(focused) {
+		for _, ks := range elementKeyShortcuts(el) {
+			ksk := ks.Key()
+			if ksk.Equal(k) {
+				cb := ks.Callback()
+				cb(ctx)
+				return true
+			}
+		}
+		if a.triggerAction(ctx, keymap.Class(el.Class()), k, el) {
+			return true
+		}
+	}
+	return false
+}
+
+// focusChain returns the supplied focused element followed by its ancestor
+// Elements, from the closest outward.
+func focusChain(focused any) []types.Element {
+	chain := []types.Element{}
+	for subject := focused; subject != nil; {
+		if el, ok := subject.(types.Element); ok {
+			chain = append(chain, el)
+		}
+		n, ok := subject.(types.Node)
+		if !ok || n.Parent() == nil {
+			break
+		}
+		subject = n.Parent()
+	}
+	return chain
+}
+
+// elementKeyShortcuts returns the KeyShortcuts of the supplied Element that
+// are active only while the focus is within it, if it is a
+// KeyShortcutHandler. A ViewKeyShortcutHandler's KeyShortcuts are active
+// whenever its View is, so none are returned for it.
+func elementKeyShortcuts(el types.Element) []types.KeyShortcut {
+	if _, ok := el.(types.ViewKeyShortcutHandler); ok {
+		return nil
+	}
+	h, ok := el.(types.KeyShortcutHandler)
+	if !ok {
+		return nil
 	}
+	return h.KeyShortcuts()
 }
@@ -0,0 +1,181 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/jaypipes/gt/core/keymap"
+	gtlog "github.com/jaypipes/gt/core/log"
+	"github.com/jaypipes/gt/types"
+)
+
+// RegisterMode registers a named input Mode, replacing any Mode already
+// registered with the same name. Any conflicts found in the Mode's Keymap,
+// such as bindings to unknown Actions, are logged as warnings.
+func (a *Application) RegisterMode(ctx context.Context, m types.Mode) {
+	if m == nil {
+		return
+	}
+	a.Lock()
+	if a.modes == nil {
+		a.modes = map[string]types.Mode{}
+	}
+	a.modes[m.Name()] = m
+	a.Unlock()
+	if km := m.Keymap(); km != nil {
+		for _, c := range keymap.Check(km, a) {
+			gtlog.Warn(ctx, "Application.RegisterMode[%s]: %s", m.Name(), c)
+		}
+	}
+}
+
+// Mode returns the registered Mode with the supplied name, or nil.
+func (a *Application) Mode(name string) types.Mode {
+	a.RLock()
+	defer a.RUnlock()
+	return a.modes[name]
+}
+
+// Modes returns all registered Modes, sorted by name.
+func (a *Application) Modes() []types.Mode {
+	a.RLock()
+	defer a.RUnlock()
+	out := make([]types.Mode, 0, len(a.modes))
+	for _, m := range a.modes {
+		out = append(out, m)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Name() < out[j].Name()
+	})
+	return out
+}
+
+// CurrentMode returns the current Mode, or nil if no Mode is set.
+func (a *Application) CurrentMode() types.Mode {
+	a.RLock()
+	defer a.RUnlock()
+	return a.mode
+}
+
+// SetMode makes the registered Mode with the supplied name the current Mode.
+// While a Mode is current, the bindings in its Keymap win over those in the
+// Application's Keymap. An empty name clears the current Mode.
+func (a *Application) SetMode(ctx context.Context, name string) error {
+	var m types.Mode
+	if name != "" {
+		m = a.Mode(name)
+		if m == nil {
+			return fmt.Errorf("unknown mode %q", name)
+		}
+	}
+	// An explicit change of Mode is not undone when a text input stops
+	// intercepting key presses.
+	a.Lock()
+	a.modeBeforeInsert = nil
+	a.autoInsert = false
+	a.Unlock()
+	a.setMode(ctx, m)
+	return nil
+}
+
+// OnModeChange registers a callback that will be executed when the current
+// Mode changes.
+func (a *Application) OnModeChange(cb types.ModeChangeCallback) {
+	a.Lock()
+	defer a.Unlock()
+	a.onModeChange = append(a.onModeChange, cb)
+}
+
+// setMode makes the supplied Mode the current Mode, executing any
+// OnModeChange callbacks and redrawing the screen if the Mode changed.
+func (a *Application) setMode(ctx context.Context, m types.Mode) {
+	a.Lock()
+	from := a.mode
+	if from == m {
+		a.Unlock()
+		return
+	}
+	a.mode = m
+	callbacks := a.onModeChange
+	a.Unlock()
+	gtlog.Debug(
+		ctx, "Application.setMode: from=%s to=%s",
+		modeName(from), modeName(m),
+	)
+	for _, cb := range callbacks {
+		cb(ctx, from, m)
+	}
+	if a.screen != nil {
+		a.Redraw()
+	}
+}
+
+// insertMode returns the first registered insert-like Mode by name, or nil.
+func (a *Application) insertMode() types.Mode {
+	for _, m := range a.Modes() {
+		if m.Insert() {
+			return m
+		}
+	}
+	return nil
+}
+
+// enterInsertMode switches to the insert-like Mode, if one is registered and
+// it is not already current, remembering the Mode to return to.
+func (a *Application) enterInsertMode(ctx context.Context) {
+	insert := a.insertMode()
+	if insert == nil {
+		return
+	}
+	a.Lock()
+	current := a.mode
+	if current == insert {
+		a.Unlock()
+		return
+	}
+	a.modeBeforeInsert = current
+	a.autoInsert = true
+	a.Unlock()
+	a.setMode(ctx, insert)
+}
+
+// exitInsertMode returns to the Mode that was current before
+// enterInsertMode, unless the current Mode was changed in the meantime.
+func (a *Application) exitInsertMode(ctx context.Context) {
+	a.Lock()
+	if !a.autoInsert {
+		a.Unlock()
+		return
+	}
+	prev := a.modeBeforeInsert
+	current := a.mode
+	a.modeBeforeInsert = nil
+	a.autoInsert = false
+	a.Unlock()
+	if current == nil || !current.Insert() {
+		return
+	}
+	a.setMode(ctx, prev)
+}
+
+// keymaps returns the current Mode's Keymap, if any, followed by the
+// Application's Keymap, if any.
+func (a *Application) keymaps() []types.Keymap {
+	kms := []types.Keymap{}
+	if m := a.CurrentMode(); m != nil && m.Keymap() != nil {
+		kms = append(kms, m.Keymap())
+	}
+	if km := a.Keymap(); km != nil {
+		kms = append(kms, km)
+	}
+	return kms
+}
+
+// modeName returns the name of the supplied Mode, or "none".
+func modeName(m types.Mode) string {
+	if m == nil {
+		return "none"
+	}
+	return m.Name()
+}
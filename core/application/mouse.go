@@ -54,6 +54,31 @@ func (a *Application) setHover(
 	return redraw
 }
 
+// setPressed sets the thing a mouse button is currently held down on, calling
+// MousePress on the previously-pressed thing to release it and on the newly
+// pressed thing. Returns whether the screen should redraw.
+func (a *Application) setPressed(
+	ctx context.Context,
+	m types.MouseEventHandler,
+	ev types.MouseEvent,
+) bool {
+	a.Lock()
+	prev := a.pressed
+	a.pressed = m
+	a.Unlock()
+
+	if prev == nil && m == nil {
+		return false
+	}
+	if prev != nil {
+		prev.MousePress(ctx, mevent.NewPressEvent(ev, false))
+	}
+	if m != nil {
+		m.MousePress(ctx, mevent.NewPressEvent(ev, true))
+	}
+	return true
+}
+
 // handleMouseEvent determines what logical action the user took with the mouse
 // and executes the appropriate mouse event handler for the target element.
 func (a *Application) handleMouseEvent(
@@ -116,6 +141,7 @@ func (a *Application) handleMouseEvent(
 				if ok {
 					a.setFocus(ctx, f)
 				}
+				a.setPressed(ctx, target, ev)
 				ce := mevent.NewClickEvent(ev, false)
 				target.MouseClick(ctx, ce)
 				redraw = true
@@ -137,6 +163,7 @@ func (a *Application) handleMouseEvent(
 				if ok {
 					a.setFocus(ctx, f)
 				}
+				a.setPressed(ctx, target, ev)
 				ce := mevent.NewClickEvent(ev, true)
 				target.MouseClick(ctx, ce)
 				redraw = true
@@ -154,6 +181,9 @@ func (a *Application) handleMouseEvent(
 			target.MouseDragStop(ctx, de)
 			redraw = true
 		}
+		if a.setPressed(ctx, nil, ev) {
+			redraw = true
+		}
 		a.mouseDownEvent = nil
 		a.mouseDragged = false
 	case !buttonWasDown && !buttonNowDown:
@@ -0,0 +1,65 @@
+package application
+
+import (
+	"github.com/gdamore/tcell/v3"
+
+	"github.com/jaypipes/gt/core/style"
+	"github.com/jaypipes/gt/types"
+)
+
+// profileScreen is a tcell.Screen that maps the colors of everything drawn to
+// it to the nearest colors supported by a color profile.
+type profileScreen struct {
+	tcell.Screen
+	// profile is the color profile that colors are mapped to.
+	profile types.ColorProfile
+}
+
+// withColorProfile returns the supplied Screen, mapping colors to the nearest
+// colors supported by the supplied color profile when drawn.
+func withColorProfile(
+	s tcell.Screen,
+	profile types.ColorProfile,
+) tcell.Screen {
+	if profile == types.ColorProfileTrueColor {
+		return s
+	}
+	return &profileScreen{Screen: s, profile: profile}
+}
+
+// Fill fills the screen with the supplied character and style.
+func (s *profileScreen) Fill(r rune, st tcell.Style) {
+	s.Screen.Fill(r, style.DegradeTCell(st, s.profile))
+}
+
+// Put writes the first grapheme of the supplied string at the supplied
+// position.
+func (s *profileScreen) Put(
+	x, y int,
+	str string,
+	st tcell.Style,
+) (string, int) {
+	return s.Screen.Put(x, y, str, style.DegradeTCell(st, s.profile))
+}
+
+// PutStrStyled writes the supplied string at the supplied position.
+func (s *profileScreen) PutStrStyled(x, y int, str string, st tcell.Style) {
+	s.Screen.PutStrStyled(x, y, str, style.DegradeTCell(st, s.profile))
+}
+
+// SetContent sets the contents of the cell at the supplied position.
+func (s *profileScreen) SetContent(
+	x, y int,
+	primary rune,
+	combining []rune,
+	st tcell.Style,
+) {
+	s.Screen.SetContent(
+		x, y, primary, combining, style.DegradeTCell(st, s.profile),
+	)
+}
+
+// SetStyle sets the default style used when clearing the screen.
+func (s *profileScreen) SetStyle(st tcell.Style) {
+	s.Screen.SetStyle(style.DegradeTCell(st, s.profile))
+}
@@ -0,0 +1,271 @@
+package application
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/gdamore/tcell/v3"
+
+	kpevent "github.com/jaypipes/gt/core/event/keypress"
+	"github.com/jaypipes/gt/core/key"
+	gtlog "github.com/jaypipes/gt/core/log"
+	"github.com/jaypipes/gt/types"
+)
+
+const (
+	defaultKeySequenceTimeout = time.Second
+)
+
+// keySequenceTimeout is the payload of the interrupt event posted to the
+// Screen's event queue when the Application has waited too long for the next
+// key press combination of a sequence.
+type keySequenceTimeout struct {
+	// gen is the generation of the pending sequence that timed out.
+	gen int
+}
+
+// KeySequenceTimeout returns how long the Application waits for the next key
+// press combination of a multi-key sequence, e.g. "g g".
+func (a *Application) KeySequenceTimeout() time.Duration {
+	a.RLock()
+	defer a.RUnlock()
+	if a.keySequenceTimeout <= 0 {
+		return defaultKeySequenceTimeout
+	}
+	return a.keySequenceTimeout
+}
+
+// SetKeySequenceTimeout sets how long the Application waits for the next key
+// press combination of a multi-key sequence before abandoning the sequence.
+// If the keys pressed so far are themselves bound, e.g. "g" when "g g" is
+// also bound, the shorter binding fires when the timeout expires. Otherwise,
+// the keys are dispatched as plain key presses.
+//
+// The default is one second.
+func (a *Application) SetKeySequenceTimeout(d time.Duration) {
+	a.Lock()
+	defer a.Unlock()
+	a.keySequenceTimeout = d
+}
+
+// PendingKeys returns the key press combinations pressed so far of a
+// multi-key sequence, or nil if no sequence is pending.
+func (a *Application) PendingKeys() types.Key {
+	a.RLock()
+	defer a.RUnlock()
+	if len(a.pendingKeys) == 0 {
+		return nil
+	}
+	return key.Sequence(a.pendingKeys...)
+}
+
+// SetShowPendingKeys sets whether the Application displays the key press
+// combinations of a pending multi-key sequence in the bottom right corner of
+// the screen. It is on by default.
+func (a *Application) SetShowPendingKeys(on bool) {
+	a.Lock()
+	defer a.Unlock()
+	a.hidePendingKeys = !on
+}
+
+// sequenceKeyPressEvent returns the supplied KeyPressEvent, with its Key
+// replaced by the full sequence if the key press completes a pending
+// multi-key sequence. It returns false if the key press was consumed because
+// it starts or continues a sequence, or cancels one with Escape.
+func (a *Application) sequenceKeyPressEvent(
+	ctx context.Context,
+	ev types.KeyPressEvent,
+) (types.KeyPressEvent, bool) {
+	a.RLock()
+	pending := a.pendingKeys
+	a.RUnlock()
+
+	k := ev.Key()
+	if len(pending) > 0 && key.KeyEscape.Equal(k) {
+		gtlog.Debug(ctx, "Application: cancelled key sequence")
+		a.clearPendingKeys(ctx)
+		return nil, false
+	}
+	seq := key.Sequence(append(pending, k)...)
+	exact, longer := false, false
+	for _, bound := range a.boundKeys() {
+		switch {
+		case bound.Equal(seq):
+			exact = true
+		case key.HasPrefix(bound, seq):
+			longer = true
+		}
+	}
+	switch {
+	case longer:
+		a.setPendingKeys(ctx, seq.Sequence())
+		return nil, false
+	case len(pending) > 0 && !exact:
+		// The key press does not continue the pending sequence, so we
+		// abandon the sequence, dispatch the keys pressed so far and then
+		// handle the key press on its own.
+		gtlog.Debug(ctx, "Application: abandoned key sequence %q", seq)
+		a.clearPendingKeys(ctx)
+		a.dispatchKeys(ctx, pending)
+		return a.sequenceKeyPressEvent(ctx, ev)
+	case len(pending) > 0:
+		a.clearPendingKeys(ctx)
+		ev.SetKey(seq)
+	}
+	return ev, true
+}
+
+// keySequenceTimedOut handles the expiry of the timeout for the supplied
+// generation of pending key sequence by dispatching the keys pressed so far.
+func (a *Application) keySequenceTimedOut(ctx context.Context, gen int) {
+	a.RLock()
+	pending := a.pendingKeys
+	current := a.pendingGen
+	a.RUnlock()
+	if gen != current || len(pending) == 0 {
+		return
+	}
+	gtlog.Debug(
+		ctx, "Application: key sequence %q timed out",
+		key.Sequence(pending...),
+	)
+	a.clearPendingKeys(ctx)
+	a.dispatchKeys(ctx, pending)
+}
+
+// dispatchKeys dispatches the supplied key press combinations of an
+// abandoned or timed out multi-key sequence. The longest bound sequence at
+// the start of the keys fires its binding, e.g. "g" when "g g" is also
+// bound, and keys that start no bound sequence are dispatched as plain key
+// presses so that the focused element still receives them.
+func (a *Application) dispatchKeys(ctx context.Context, keys []types.Key) {
+	bound := a.boundKeys()
+	for len(keys) > 0 {
+		n := 1
+		var k types.Key = keys[0]
+		for x := len(keys); x > 1; x-- {
+			seq := key.Sequence(keys[:x]...)
+			if slices.ContainsFunc(bound, seq.Equal) {
+				n = x
+				k = seq
+				break
+			}
+		}
+		a.dispatchKeyPressEvent(ctx, kpevent.New(kpevent.WithKey(k)))
+		keys = keys[n:]
+	}
+}
+
+// setPendingKeys sets the pending key press combinations of a multi-key
+// sequence, redraws the screen and starts the timeout for the next key press.
+func (a *Application) setPendingKeys(
+	ctx context.Context,
+	pending []types.Key,
+) {
+	timeout := a.KeySequenceTimeout()
+	a.Lock()
+	a.pendingKeys = pending
+	a.pendingGen++
+	gen := a.pendingGen
+	a.stopPendingTimer()
+	a.pendingTimer = time.AfterFunc(timeout, func() {
+		a.postEvent(tcell.NewEventInterrupt(keySequenceTimeout{gen: gen}))
+	})
+	a.Unlock()
+	a.draw(ctx)
+}
+
+// clearPendingKeys discards any pending key press combinations of a multi-key
+// sequence, redrawing the screen to remove them from display.
+func (a *Application) clearPendingKeys(ctx context.Context) {
+	a.Lock()
+	had := len(a.pendingKeys) > 0
+	a.pendingKeys = nil
+	a.pendingGen++
+	a.stopPendingTimer()
+	a.Unlock()
+	if had {
+		a.draw(ctx)
+	}
+}
+
+// stopPendingTimer stops the timeout of the pending key press combinations
+// of a multi-key sequence, if any. The caller must hold the Application's
+// lock.
+func (a *Application) stopPendingTimer() {
+	if a.pendingTimer != nil {
+		a.pendingTimer.Stop()
+		a.pendingTimer = nil
+	}
+}
+
+// boundKeys returns the Keys of the Application-level KeyShortcuts, the
+// Keymap bindings that currently apply, the KeyShortcuts of the focused
+// element and its ancestors, the Views' active keys, and the KeyShortcuts of
+// the active View and any ViewKeyShortcutHandlers it contains.
+func (a *Application) boundKeys() []types.Key {
+	a.RLock()
+	views := a.views
+	activeView := a.ActiveView()
+	focused := a.focused
+	keys := []types.Key{}
+	keys = append(keys, a.focusNextKeys...)
+	for _, ks := range a.keyShortcuts {
+		keys = append(keys, ks.Key())
+	}
+	a.RUnlock()
+	keys = append(keys, a.keymapKeys(activeView, focused)...)
+	for _, el := range focusChain(focused) {
+		for _, ks := range elementKeyShortcuts(el) {
+			keys = append(keys, ks.Key())
+		}
+	}
+	for _, v := range views {
+		if vk := v.ActiveKey(); vk != nil {
+			keys = append(keys, vk)
+		}
+	}
+	var walk func(subject any)
+	walk = func(subject any) {
+		if h, ok := subject.(types.ViewKeyShortcutHandler); ok {
+			for _, ks := range h.ViewKeyShortcuts() {
+				keys = append(keys, ks.Key())
+			}
+		}
+		if n, ok := subject.(types.Node); ok {
+			for _, child := range n.Children() {
+				walk(child)
+			}
+		}
+	}
+	if activeView != nil {
+		for _, ks := range activeView.KeyShortcuts() {
+			keys = append(keys, ks.Key())
+		}
+		walk(activeView)
+	}
+	return keys
+}
+
+// drawPendingKeys draws the pending key press combinations of a multi-key
+// sequence in the bottom right corner of the Application's inner bounds.
+func (a *Application) drawPendingKeys() {
+	a.RLock()
+	pending := a.pendingKeys
+	hide := a.hidePendingKeys
+	a.RUnlock()
+	if hide || len(pending) == 0 {
+		return
+	}
+	text := " " + key.Sequence(pending...).String() + " "
+	inner := a.InnerBounds()
+	x := inner.Max.X - ansi.StringWidth(text)
+	if x < inner.Min.X {
+		x = inner.Min.X
+	}
+	a.screen.PutStrStyled(
+		x, inner.Max.Y-1, text, tcell.StyleDefault.Reverse(true),
+	)
+}
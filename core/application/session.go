@@ -0,0 +1,129 @@
+package application
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gdamore/tcell/v3"
+
+	gtcontext "github.com/jaypipes/gt/core/context"
+	gtlog "github.com/jaypipes/gt/core/log"
+	"github.com/jaypipes/gt/core/session"
+)
+
+// replayEvent is the payload of the interrupt event that wraps an input
+// event replayed from a recorded session.
+type replayEvent struct {
+	ev tcell.Event
+}
+
+// replayDone is the payload of the interrupt event posted once every event of
+// a recorded session has been replayed.
+type replayDone struct{}
+
+// startRecording returns a new Recorder writing to the session file at the
+// context's record path, or nil if no path is set. The terminal's background
+// and the Screen's size are recorded first, so that a replay selects the same
+// Theme variant and a HeadlessScreen can be sized with session.Size.
+func (a *Application) startRecording(
+	ctx context.Context,
+) (*session.Recorder, error) {
+	path := gtcontext.RecordPath(ctx)
+	if path == "" {
+		return nil, nil
+	}
+	rec, err := session.CreateRecorder(path)
+	if err != nil {
+		return nil, err
+	}
+	gtlog.Debug(ctx, "Application.startRecording: path=%s", path)
+	if err := rec.RecordBackground(a.darkBackground); err != nil {
+		rec.Close()
+		return nil, err
+	}
+	w, h := a.screen.Size()
+	if err := rec.Record(tcell.NewEventResize(w, h)); err != nil {
+		rec.Close()
+		return nil, err
+	}
+	return rec, nil
+}
+
+// startReplay starts replaying the recorded session file at the context's
+// replay path, if set, posting its events to the Screen's event queue until
+// the context is done. The goroutine posting the events is added to the
+// supplied WaitGroup so that it can be joined before the Screen is finalized.
+// The terminal's background recorded in the session, if any, replaces the
+// detected one. It returns whether a session is being replayed and the
+// channel that advances a stepped replay by one event.
+func (a *Application) startReplay(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+) (bool, chan struct{}, error) {
+	path := gtcontext.ReplayPath(ctx)
+	if path == "" {
+		return false, nil, nil
+	}
+	events, err := session.LoadFile(path)
+	if err != nil {
+		return false, nil, err
+	}
+	if dark, ok := session.DarkBackground(events); ok {
+		a.darkBackground = dark
+	}
+	p := session.NewPlayer(
+		events,
+		session.WithSpeed(gtcontext.ReplaySpeed(ctx)),
+		session.WithStep(gtcontext.ReplayStep(ctx)),
+	)
+	gtlog.Debug(
+		ctx, "Application.startReplay: path=%s events=%d",
+		path, p.Len(),
+	)
+	q := a.screen.EventQ()
+	// post blocks until the event loop has room for the event, so that no
+	// replayed event is dropped, but never posts once the context is done.
+	post := func(ev tcell.Event) {
+		if ctx.Err() != nil {
+			return
+		}
+		select {
+		case q <- ev:
+		case <-ctx.Done():
+		}
+	}
+	advance := make(chan struct{}, 1)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		wrap := func(ev tcell.Event) {
+			post(tcell.NewEventInterrupt(replayEvent{ev: ev}))
+		}
+		if err := p.Play(ctx, wrap, advance); err != nil {
+			return
+		}
+		post(tcell.NewEventInterrupt(replayDone{}))
+	}()
+	return true, advance, nil
+}
+
+// replayedEvent returns the input event wrapped by the supplied event, if it
+// was replayed from a recorded session.
+func replayedEvent(ev tcell.Event) (tcell.Event, bool) {
+	ie, ok := ev.(*tcell.EventInterrupt)
+	if !ok {
+		return nil, false
+	}
+	re, ok := ie.Data().(replayEvent)
+	if !ok {
+		return nil, false
+	}
+	return re.ev, true
+}
+
+// isInputEvent returns true if the supplied event is a kind of input event
+// that is recorded and replayed.
+func isInputEvent(ev tcell.Event) bool {
+	_, ok := session.FromTCell(0, ev)
+	return ok
+}
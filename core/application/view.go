@@ -15,6 +15,8 @@ func (a *Application) View(ctx context.Context, id string) types.View {
 	v, ok := a.views[id]
 	if !ok {
 		v = view.New(ctx, view.WithID(id))
+		v.SetThemeProvider(a)
+		v.SetStylesheetProvider(a)
 		a.views[id] = v
 		a.activeView = id
 	}
@@ -33,6 +33,10 @@ type Border struct {
 	// color, this will be set, otherwise individual edge Cells might have
 	// their own Style.
 	bgColor types.Color
+	// title is the optional label embedded in the top edge of the Border.
+	title types.BorderLabel
+	// footer is the optional label embedded in the bottom edge of the Border.
+	footer types.BorderLabel
 }
 
 // Empty returns true if none of the Border's edges or corners have content.
@@ -196,6 +200,42 @@ func (b *Border) WithBackgroundColor(color types.Color) types.Border {
 	return b
 }
 
+// Title returns the BorderLabel embedded in the top edge of the Border, if
+// any.
+func (b *Border) Title() types.BorderLabel {
+	return b.title
+}
+
+// SetTitle sets the BorderLabel embedded in the top edge of the Border.
+func (b *Border) SetTitle(label types.BorderLabel) {
+	b.title = label
+}
+
+// WithTitle sets the BorderLabel embedded in the top edge of the Border and
+// returns the Border.
+func (b *Border) WithTitle(label types.BorderLabel) types.Border {
+	b.title = label
+	return b
+}
+
+// Footer returns the BorderLabel embedded in the bottom edge of the Border, if
+// any.
+func (b *Border) Footer() types.BorderLabel {
+	return b.footer
+}
+
+// SetFooter sets the BorderLabel embedded in the bottom edge of the Border.
+func (b *Border) SetFooter(label types.BorderLabel) {
+	b.footer = label
+}
+
+// WithFooter sets the BorderLabel embedded in the bottom edge of the Border
+// and returns the Border.
+func (b *Border) WithFooter(label types.BorderLabel) types.Border {
+	b.footer = label
+	return b
+}
+
 // HorizontalSpace returns the number of cells the supplied Border
 // consumes.
 func (b *Border) HorizontalSpace() types.Dimension {
@@ -0,0 +1,69 @@
+package border
+
+import (
+	"github.com/jaypipes/gt/types"
+)
+
+// Label is a piece of text, such as a title or footer, that is embedded in
+// the top or bottom edge of a Border.
+type Label struct {
+	// content is the Label's text.
+	content string
+	// align is the horizontal alignment of the Label within the Border's
+	// edge.
+	align types.Alignment
+	// style is the Style used when drawing the Label's text, if any.
+	style types.Style
+	// ldelim is the string drawn immediately to the left of the Label's text.
+	ldelim string
+	// rdelim is the string drawn immediately to the right of the Label's
+	// text.
+	rdelim string
+}
+
+// Content returns the Label's text.
+func (l *Label) Content() string {
+	return l.content
+}
+
+// SetContent sets the Label's text.
+func (l *Label) SetContent(content string) {
+	l.content = content
+}
+
+// Alignment returns the horizontal alignment of the Label within the Border's
+// edge.
+func (l *Label) Alignment() types.Alignment {
+	return l.align
+}
+
+// SetAlignment sets the horizontal alignment of the Label within the Border's
+// edge.
+func (l *Label) SetAlignment(align types.Alignment) {
+	l.align = align
+}
+
+// Style returns the Style used when drawing the Label's text, if any.
+func (l *Label) Style() types.Style {
+	return l.style
+}
+
+// SetStyle sets the Style used when drawing the Label's text.
+func (l *Label) SetStyle(style types.Style) {
+	l.style = style
+}
+
+// Delimiters returns the strings drawn immediately to the left and right of
+// the Label's text.
+func (l *Label) Delimiters() (string, string) {
+	return l.ldelim, l.rdelim
+}
+
+// SetDelimiters sets the strings drawn immediately to the left and right of
+// the Label's text.
+func (l *Label) SetDelimiters(left, right string) {
+	l.ldelim = left
+	l.rdelim = right
+}
+
+var _ types.BorderLabel = (*Label)(nil)
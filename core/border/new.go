@@ -149,3 +149,70 @@ func WithBackgroundColor(color types.Color) types.BorderWithOption {
 		b.SetBackgroundColor(color)
 	}
 }
+
+// WithTitle sets the Border's title, embedded in the top edge. The argument
+// can be either a [types.BorderLabel] or a string. If a string, we create a
+// left-aligned, unstyled [types.BorderLabel] with that string content.
+func WithTitle(arg any) types.BorderWithOption {
+	return func(b types.Border) {
+		switch arg := arg.(type) {
+		case types.BorderLabel:
+			b.SetTitle(arg)
+		case string:
+			b.SetTitle(NewLabel(arg))
+		}
+	}
+}
+
+// WithFooter sets the Border's footer, embedded in the bottom edge. The
+// argument can be either a [types.BorderLabel] or a string. If a string, we
+// create a left-aligned, unstyled [types.BorderLabel] with that string
+// content.
+func WithFooter(arg any) types.BorderWithOption {
+	return func(b types.Border) {
+		switch arg := arg.(type) {
+		case types.BorderLabel:
+			b.SetFooter(arg)
+		case string:
+			b.SetFooter(NewLabel(arg))
+		}
+	}
+}
+
+// NewLabel returns a new BorderLabel with the supplied text content.
+//
+// You can pass zero or more BorderLabelWithOptions to optionally set certain
+// attributes on the returned BorderLabel.
+func NewLabel(
+	content string,
+	opts ...types.BorderLabelWithOption,
+) types.BorderLabel {
+	l := &Label{content: content}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// WithLabelAlignment sets the types.BorderLabel's horizontal alignment to the
+// supplied value.
+func WithLabelAlignment(align types.Alignment) types.BorderLabelWithOption {
+	return func(l types.BorderLabel) {
+		l.SetAlignment(align)
+	}
+}
+
+// WithLabelStyle sets the types.BorderLabel's style to the supplied value.
+func WithLabelStyle(style types.Style) types.BorderLabelWithOption {
+	return func(l types.BorderLabel) {
+		l.SetStyle(style)
+	}
+}
+
+// WithLabelDelimiters sets the strings drawn to the left and right of the
+// types.BorderLabel's text to the supplied values.
+func WithLabelDelimiters(left, right string) types.BorderLabelWithOption {
+	return func(l types.BorderLabel) {
+		l.SetDelimiters(left, right)
+	}
+}
@@ -0,0 +1,66 @@
+package border
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/jaypipes/gt/types"
+)
+
+var (
+	registryLock sync.RWMutex
+	registry     = map[string]func() types.Border{
+		"none":             None,
+		"normal":           Normal,
+		"rounded":          Rounded,
+		"block":            Block,
+		"outer-half-block": OuterHalfBlock,
+		"inner-half-block": InnerHalfBlock,
+		"thick":            Thick,
+		"double":           Double,
+		"hidden":           Hidden,
+		"markdown":         Markdown,
+		"ascii":            ASCII,
+		"dashed":           Dashed,
+		"heavy-dashed":     HeavyDashed,
+		"dotted":           Dotted,
+		"heavy-dotted":     HeavyDotted,
+	}
+)
+
+// Register adds a named Border variant to the registry, replacing any variant
+// already registered with that name. The supplied function is called to
+// return a new Border each time the variant is looked up. Names are
+// case-insensitive.
+//
+// Registered variants can be referred to by name from theme files.
+func Register(name string, ctor func() types.Border) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	registry[strings.ToLower(name)] = ctor
+}
+
+// Lookup returns a new Border of the variant registered with the supplied name
+// and whether the name is registered.
+func Lookup(name string) (types.Border, bool) {
+	registryLock.RLock()
+	ctor, ok := registry[strings.ToLower(name)]
+	registryLock.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return ctor(), true
+}
+
+// Names returns the sorted names of all registered Border variants.
+func Names() []string {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,87 @@
+package border
+
+import (
+	"unicode/utf8"
+
+	"github.com/jaypipes/gt/core/cell"
+	"github.com/jaypipes/gt/core/graphic"
+	"github.com/jaypipes/gt/types"
+)
+
+// Sides returns a new Border whose edges are taken from the same side of the
+// supplied Borders, in top, right, bottom, left order. For example,
+// Sides(Dashed(), Normal(), Normal(), Normal()) has a dashed top edge and
+// solid right, bottom and left edges. A nil Border leaves that edge empty.
+//
+// Each corner is taken from the Borders on either side of it when they agree
+// on it. Otherwise, the corner joins the lines of the two edges, e.g. (┍)
+// for a thick top edge and a normal left edge. If the edges are not line
+// drawing characters, the corner of the top or bottom Border is used.
+func Sides(top, right, bottom, left types.Border) types.Border {
+	b := &Border{}
+	if top != nil {
+		b.t = top.T()
+	}
+	if right != nil {
+		b.r = right.R()
+	}
+	if bottom != nil {
+		b.b = bottom.B()
+	}
+	if left != nil {
+		b.l = left.L()
+	}
+	b.tl = sideCorner(top, left, types.Border.TL, b.t, b.l, true, true)
+	b.tr = sideCorner(top, right, types.Border.TR, b.t, b.r, false, true)
+	b.bl = sideCorner(bottom, left, types.Border.BL, b.b, b.l, true, false)
+	b.br = sideCorner(bottom, right, types.Border.BR, b.b, b.r, false, false)
+	return b
+}
+
+// sideCorner returns the corner Cell between the supplied horizontal and
+// vertical side Borders and their edge Cells.
+func sideCorner(
+	horizontal, vertical types.Border,
+	corner func(types.Border) types.Cell,
+	hEdge, vEdge types.Cell,
+	right, down bool,
+) types.Cell {
+	var hc, vc types.Cell
+	if horizontal != nil {
+		hc = corner(horizontal)
+	}
+	if vertical != nil {
+		vc = corner(vertical)
+	}
+	if hc == nil || vc == nil {
+		if hc != nil {
+			return hc
+		}
+		return vc
+	}
+	if hc.Content() == vc.Content() {
+		return hc
+	}
+	hr, hok := cellRune(hEdge)
+	vr, vok := cellRune(vEdge)
+	if hok && vok {
+		if r, ok := graphic.BoxDrawingCorner(hr, vr, right, down); ok {
+			return cell.New(cell.WithContent(r)).WithStyle(hc.Style())
+		}
+	}
+	return hc
+}
+
+// cellRune returns the content of the supplied Cell and whether it is a
+// single rune.
+func cellRune(c types.Cell) (rune, bool) {
+	if c == nil {
+		return 0, false
+	}
+	content := c.Content()
+	if utf8.RuneCountInString(content) != 1 {
+		return 0, false
+	}
+	r, _ := utf8.DecodeRuneInString(content)
+	return r, true
+}
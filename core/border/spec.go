@@ -0,0 +1,81 @@
+package border
+
+import (
+	"fmt"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/jaypipes/gt/core/cell"
+	"github.com/jaypipes/gt/types"
+)
+
+// FromSpec returns a new Border built from a compact string of its glyphs,
+// listed row by row as they appear on the Screen: the top left corner, top
+// edge and top right corner, then the left edge, center and right edge, then
+// the bottom left corner, bottom edge and bottom right corner. For example,
+// "╭─╮│ │╰─╯" describes the Rounded border. The center glyph is ignored and
+// may be left out, giving a spec of eight glyphs.
+func FromSpec(spec string) (types.Border, error) {
+	glyphs := []rune(spec)
+	switch len(glyphs) {
+	case 8:
+		// Insert the missing center so both forms index the same way.
+		glyphs = slices.Insert(glyphs, 4, ' ')
+	case 9:
+	default:
+		return nil, fmt.Errorf(
+			"border spec %q has %d glyphs. expected 8 or 9",
+			spec, len(glyphs),
+		)
+	}
+	glyph := func(x int) types.Cell {
+		return cell.New(cell.WithContent(string(glyphs[x])))
+	}
+	return &Border{
+		tl: glyph(0),
+		t:  glyph(1),
+		tr: glyph(2),
+		l:  glyph(3),
+		r:  glyph(5),
+		bl: glyph(6),
+		b:  glyph(7),
+		br: glyph(8),
+	}, nil
+}
+
+// MustFromSpec is like FromSpec but panics if the spec is invalid. It is
+// intended for initializing package-level Borders.
+func MustFromSpec(spec string) types.Border {
+	b, err := FromSpec(spec)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// Spec returns the compact string of the supplied Border's glyphs, with a
+// space for the center, as understood by FromSpec. It returns an empty
+// string if any of the Border's edges or corners is missing or is not a
+// single glyph.
+func Spec(b types.Border) string {
+	cells := []types.Cell{
+		b.TL(), b.T(), b.TR(), b.L(), nil, b.R(), b.BL(), b.B(), b.BR(),
+	}
+	spec := make([]rune, 0, len(cells))
+	for x, c := range cells {
+		if x == 4 {
+			spec = append(spec, ' ')
+			continue
+		}
+		if c == nil {
+			return ""
+		}
+		content := c.Content()
+		if utf8.RuneCountInString(content) != 1 {
+			return ""
+		}
+		r, _ := utf8.DecodeRuneInString(content)
+		spec = append(spec, r)
+	}
+	return string(spec)
+}
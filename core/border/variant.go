@@ -153,3 +153,59 @@ func ASCII() types.Border {
 		br: cell.New(cell.WithContent(graphic.Plus)),
 	}
 }
+
+// Dashed returns a border with dashed edges and normal corners.
+func Dashed() types.Border {
+	return &Border{
+		t:  cell.New(cell.WithContent(graphic.BoxDrawingLightDoubleDashHorizontal)),
+		b:  cell.New(cell.WithContent(graphic.BoxDrawingLightDoubleDashHorizontal)),
+		l:  cell.New(cell.WithContent(graphic.BoxDrawingLightDoubleDashVertical)),
+		r:  cell.New(cell.WithContent(graphic.BoxDrawingLightDoubleDashVertical)),
+		tl: cell.New(cell.WithContent(graphic.BoxDrawingLightDownAndRight)),
+		tr: cell.New(cell.WithContent(graphic.BoxDrawingLightDownAndLeft)),
+		bl: cell.New(cell.WithContent(graphic.BoxDrawingLightUpAndRight)),
+		br: cell.New(cell.WithContent(graphic.BoxDrawingLightUpAndLeft)),
+	}
+}
+
+// HeavyDashed returns a border with thick dashed edges and thick corners.
+func HeavyDashed() types.Border {
+	return &Border{
+		t:  cell.New(cell.WithContent(graphic.BoxDrawingHeavyDoubleDashHorizontal)),
+		b:  cell.New(cell.WithContent(graphic.BoxDrawingHeavyDoubleDashHorizontal)),
+		l:  cell.New(cell.WithContent(graphic.BoxDrawingHeavyDoubleDashVertical)),
+		r:  cell.New(cell.WithContent(graphic.BoxDrawingHeavyDoubleDashVertical)),
+		tl: cell.New(cell.WithContent(graphic.BoxDrawingHeavyDownAndRight)),
+		tr: cell.New(cell.WithContent(graphic.BoxDrawingHeavyDownAndLeft)),
+		bl: cell.New(cell.WithContent(graphic.BoxDrawingHeavyUpAndRight)),
+		br: cell.New(cell.WithContent(graphic.BoxDrawingHeavyUpAndLeft)),
+	}
+}
+
+// Dotted returns a border with dotted edges and normal corners.
+func Dotted() types.Border {
+	return &Border{
+		t:  cell.New(cell.WithContent(graphic.BoxDrawingLightQuadrupleDashHorizontal)),
+		b:  cell.New(cell.WithContent(graphic.BoxDrawingLightQuadrupleDashHorizontal)),
+		l:  cell.New(cell.WithContent(graphic.BoxDrawingLightQuadrupleDashVertical)),
+		r:  cell.New(cell.WithContent(graphic.BoxDrawingLightQuadrupleDashVertical)),
+		tl: cell.New(cell.WithContent(graphic.BoxDrawingLightDownAndRight)),
+		tr: cell.New(cell.WithContent(graphic.BoxDrawingLightDownAndLeft)),
+		bl: cell.New(cell.WithContent(graphic.BoxDrawingLightUpAndRight)),
+		br: cell.New(cell.WithContent(graphic.BoxDrawingLightUpAndLeft)),
+	}
+}
+
+// HeavyDotted returns a border with thick dotted edges and thick corners.
+func HeavyDotted() types.Border {
+	return &Border{
+		t:  cell.New(cell.WithContent(graphic.BoxDrawingHeavyQuadrupleDashHorizontal)),
+		b:  cell.New(cell.WithContent(graphic.BoxDrawingHeavyQuadrupleDashHorizontal)),
+		l:  cell.New(cell.WithContent(graphic.BoxDrawingHeavyQuadrupleDashVertical)),
+		r:  cell.New(cell.WithContent(graphic.BoxDrawingHeavyQuadrupleDashVertical)),
+		tl: cell.New(cell.WithContent(graphic.BoxDrawingHeavyDownAndRight)),
+		tr: cell.New(cell.WithContent(graphic.BoxDrawingHeavyDownAndLeft)),
+		bl: cell.New(cell.WithContent(graphic.BoxDrawingHeavyUpAndRight)),
+		br: cell.New(cell.WithContent(graphic.BoxDrawingHeavyUpAndLeft)),
+	}
+}
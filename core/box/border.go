@@ -3,7 +3,11 @@ package box
 import (
 	"context"
 	"image/color"
+	"unicode/utf8"
 
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/jaypipes/gt/core/graphic"
 	gtlog "github.com/jaypipes/gt/core/log"
 	"github.com/jaypipes/gt/core/style"
 	"github.com/jaypipes/gt/types"
@@ -19,6 +23,27 @@ func (b *Box) Border() types.Border {
 	return b.border
 }
 
+// SetBorderCollapse sets whether the bordered children of the Box share a
+// border line with their adjacent bordered siblings. Where the shared lines
+// meet, their box drawing characters are joined into junctions like (┬) and
+// (┼).
+func (b *Box) SetBorderCollapse(on bool) {
+	b.borderCollapse = on
+}
+
+// BorderCollapse returns whether the bordered children of the Box share a
+// border line with their adjacent bordered siblings.
+func (b *Box) BorderCollapse() bool {
+	return b.borderCollapse
+}
+
+// SetCollapsed sets whether the Box's border shares lines with the borders
+// of its adjacent siblings. When set, the Box's border is joined with any
+// box drawing characters already drawn in its cells.
+func (b *Box) SetCollapsed(on bool) {
+	b.collapsed = on
+}
+
 // SetBorderForegroundColor sets the Box's border foreground color
 // (i.e the color of the border cell's underlying grapheme).
 func (b *Box) SetBorderForegroundColor(c types.Color) {
@@ -51,6 +76,44 @@ func (b *Box) BorderBackgroundColor() types.Color {
 	return color.Transparent
 }
 
+// SetBorderTitle sets the label embedded in the top edge of the Box's border.
+// The Box's border title takes precedence over any title on the Box's border
+// itself.
+func (b *Box) SetBorderTitle(label types.BorderLabel) {
+	b.borderTitle = label
+}
+
+// BorderTitle returns the label embedded in the top edge of the Box's border,
+// if any.
+func (b *Box) BorderTitle() types.BorderLabel {
+	if b.borderTitle != nil {
+		return b.borderTitle
+	}
+	if b.border != nil {
+		return b.border.Title()
+	}
+	return nil
+}
+
+// SetBorderFooter sets the label embedded in the bottom edge of the Box's
+// border. The Box's border footer takes precedence over any footer on the
+// Box's border itself.
+func (b *Box) SetBorderFooter(label types.BorderLabel) {
+	b.borderFooter = label
+}
+
+// BorderFooter returns the label embedded in the bottom edge of the Box's
+// border, if any.
+func (b *Box) BorderFooter() types.BorderLabel {
+	if b.borderFooter != nil {
+		return b.borderFooter
+	}
+	if b.border != nil {
+		return b.border.Footer()
+	}
+	return nil
+}
+
 // renderBorder draws the border around the outer bounding box's cells.
 func (b *Box) renderBorder(
 	ctx context.Context,
@@ -92,7 +155,7 @@ func (b *Box) renderBorder(
 		if s == nil {
 			s = defStyle
 		}
-		screen.PutStrStyled(minX, minY, tl.Content(), style.TCell(s))
+		b.putBorder(screen, minX, minY, tl.Content(), s)
 	}
 
 	tr := border.TR()
@@ -101,7 +164,7 @@ func (b *Box) renderBorder(
 		if s == nil {
 			s = defStyle
 		}
-		screen.PutStrStyled(maxX, minY, tr.Content(), style.TCell(s))
+		b.putBorder(screen, maxX, minY, tr.Content(), s)
 	}
 
 	bl := border.BL()
@@ -110,7 +173,7 @@ func (b *Box) renderBorder(
 		if s == nil {
 			s = defStyle
 		}
-		screen.PutStrStyled(minX, maxY, bl.Content(), style.TCell(s))
+		b.putBorder(screen, minX, maxY, bl.Content(), s)
 	}
 
 	br := border.BR()
@@ -119,7 +182,7 @@ func (b *Box) renderBorder(
 		if s == nil {
 			s = defStyle
 		}
-		screen.PutStrStyled(maxX, maxY, br.Content(), style.TCell(s))
+		b.putBorder(screen, maxX, maxY, br.Content(), s)
 	}
 
 	// Draw the edges
@@ -132,7 +195,11 @@ func (b *Box) renderBorder(
 		}
 		ch := te.Content()
 		for x := minX + 1; x < maxX; x++ {
-			screen.PutStrStyled(x, minY, ch, style.TCell(s))
+			b.putBorder(screen, x, minY, ch, s)
+		}
+		title := b.BorderTitle()
+		if title != nil && ch != "" {
+			renderBorderLabel(screen, title, minX+1, maxX, minY, s)
 		}
 	}
 
@@ -144,7 +211,11 @@ func (b *Box) renderBorder(
 		}
 		ch := be.Content()
 		for x := minX + 1; x < maxX; x++ {
-			screen.PutStrStyled(x, maxY, ch, style.TCell(s))
+			b.putBorder(screen, x, maxY, ch, s)
+		}
+		footer := b.BorderFooter()
+		if footer != nil && ch != "" {
+			renderBorderLabel(screen, footer, minX+1, maxX, maxY, s)
 		}
 	}
 
@@ -156,7 +227,7 @@ func (b *Box) renderBorder(
 		}
 		ch := le.Content()
 		for y := minY + 1; y < maxY; y++ {
-			screen.PutStrStyled(minX, y, ch, style.TCell(s))
+			b.putBorder(screen, minX, y, ch, s)
 		}
 	}
 
@@ -168,7 +239,85 @@ func (b *Box) renderBorder(
 		}
 		ch := re.Content()
 		for y := minY + 1; y < maxY; y++ {
-			screen.PutStrStyled(maxX, y, ch, style.TCell(s))
+			b.putBorder(screen, maxX, y, ch, s)
+		}
+	}
+}
+
+// putBorder draws the supplied border cell content at the supplied position.
+// If the Box's border is collapsed, the content is joined with any box drawing
+// character already drawn there.
+func (b *Box) putBorder(
+	screen types.Screen,
+	x, y int,
+	content string,
+	s types.Style,
+) {
+	if b.collapsed {
+		existing, _, _ := screen.Get(x, y)
+		er, en := utf8.DecodeRuneInString(existing)
+		cr, cn := utf8.DecodeRuneInString(content)
+		if en == len(existing) && cn == len(content) && en > 0 && cn > 0 {
+			if r, ok := graphic.JoinBoxDrawing(er, cr); ok {
+				content = string(r)
+			}
 		}
 	}
+	screen.PutStrStyled(x, y, content, style.TCell(s))
+}
+
+// renderBorderLabel draws the supplied label on the line at y between startX
+// (inclusive) and stopX (exclusive), aligned according to the label's
+// alignment. If the label is too wide to fit, its delimiters are dropped and
+// its text is truncated with an ellipsis. The label's delimiters, along with
+// the label's text if the label has no Style of its own, are drawn using the
+// supplied edge Style.
+func renderBorderLabel(
+	screen types.Screen,
+	label types.BorderLabel,
+	startX int,
+	stopX int,
+	y int,
+	edgeStyle types.Style,
+) {
+	width := stopX - startX
+	content := label.Content()
+	if width <= 0 || content == "" {
+		return
+	}
+	ldelim, rdelim := label.Delimiters()
+	lw := ansi.StringWidth(ldelim)
+	rw := ansi.StringWidth(rdelim)
+	if lw+rw >= width {
+		ldelim, rdelim = "", ""
+		lw, rw = 0, 0
+	}
+	avail := width - lw - rw
+	if ansi.StringWidth(content) > avail {
+		content = ansi.Truncate(
+			content, avail, string(graphic.HorizontalEllipsis),
+		)
+	}
+	cw := ansi.StringWidth(content)
+	total := lw + cw + rw
+
+	x := startX
+	align := label.Alignment()
+	if align&types.AlignmentRight != 0 {
+		x = stopX - total
+	} else if align&types.AlignmentCenter != 0 {
+		x = startX + (width-total)/2
+	}
+
+	ls := label.Style()
+	if ls == nil {
+		ls = edgeStyle
+	}
+	if ldelim != "" {
+		screen.PutStrStyled(x, y, ldelim, style.TCell(edgeStyle))
+	}
+	screen.PutStrStyled(x+lw, y, content, style.TCell(ls))
+	if rdelim != "" {
+		screen.PutStrStyled(x+lw+cw, y, rdelim, style.TCell(edgeStyle))
+	}
 }
@@ -26,10 +26,28 @@ type Box struct {
 	// absolute is true if the Box is using absolute coordinates, false if
 	// using relative positioning.
 	absolute bool
+	// plotted is true if the Box's bounds were calculated when plotting.
+	plotted bool
 	// padding is any padding applied to the Box.
 	padding types.Padding
 	// border is the optional Border information for the Box.
 	border types.Border
+	// borderTitle is the optional label embedded in the top edge of the
+	// Box's border. When set, it takes precedence over the border's own
+	// title.
+	borderTitle types.BorderLabel
+	// borderFooter is the optional label embedded in the bottom edge of the
+	// Box's border. When set, it takes precedence over the border's own
+	// footer.
+	borderFooter types.BorderLabel
+	// borderCollapse is true if the bordered children of the Box share a
+	// border line with their adjacent bordered siblings.
+	borderCollapse bool
+	// collapsed is true if the Box's border shares lines with the borders of
+	// its adjacent siblings.
+	collapsed bool
+	// shadow is the optional drop shadow cast by the Box.
+	shadow types.Shadow
 
 	// minWidth is the minimum width of the Element.
 	minWidth types.Dimension
@@ -60,6 +78,7 @@ func (b *Box) String() string {
 
 // Render implements the types.Renderable interface
 func (b *Box) Render(ctx context.Context, h types.ScreenHandler) {
+	b.renderShadow(ctx, h)
 	b.renderBorder(ctx, h)
 }
 
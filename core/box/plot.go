@@ -7,6 +7,29 @@ import (
 // SetBounds sets the Box's outer bounding box.
 func (b *Box) SetBounds(bounds types.Rectangle) {
 	b.bounds = bounds
+	b.plotted = false
+}
+
+// SetPlottedBounds sets the Box's outer bounding box as calculated when
+// plotting the Box.
+func (b *Box) SetPlottedBounds(bounds types.Rectangle) {
+	b.bounds = bounds
+	b.plotted = true
+}
+
+// Invalidate clears any plotted bounds of the Box so that they are calculated
+// again the next time the Box is plotted. The top-left coordinates of an
+// absolutely-positioned Box are kept.
+func (b *Box) Invalidate() {
+	if !b.plotted {
+		return
+	}
+	if b.absolute {
+		b.bounds.Max = b.bounds.Min
+	} else {
+		b.bounds = types.Rectangle{}
+	}
+	b.plotted = false
 }
 
 // Bounds returns the Box's outer bounding box.
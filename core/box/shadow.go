@@ -0,0 +1,75 @@
+package box
+
+import (
+	"context"
+
+	"github.com/charmbracelet/x/ansi"
+
+	gtlog "github.com/jaypipes/gt/core/log"
+	"github.com/jaypipes/gt/core/style"
+	"github.com/jaypipes/gt/types"
+)
+
+// SetShadow sets the Box's drop shadow.
+func (b *Box) SetShadow(shadow types.Shadow) {
+	b.shadow = shadow
+}
+
+// Shadow returns the Box's drop shadow, if any.
+func (b *Box) Shadow() types.Shadow {
+	return b.shadow
+}
+
+// renderShadow draws the drop shadow, if any, in the cells to the right of and
+// below the outer bounding box. The shadow is drawn outside of the Box's
+// bounds and is clipped to the Screen.
+func (b *Box) renderShadow(
+	ctx context.Context,
+	h types.ScreenHandler,
+) {
+	shadow := b.shadow
+	if shadow == nil {
+		return
+	}
+	offset := shadow.Offset()
+	ch := shadow.Content()
+	if ch == "" || (offset.X <= 0 && offset.Y <= 0) {
+		return
+	}
+	// Shadow graphemes are expected to be a single cell wide.
+	if ansi.StringWidth(ch) != 1 {
+		return
+	}
+
+	screen := h.Screen()
+	sw, sh := screen.Size()
+	clip := types.Rectangle{Max: types.Point{X: sw, Y: sh}}
+
+	bounds := b.bounds
+	area := bounds.Add(offset).Intersect(clip)
+
+	gtlog.Debug(
+		ctx, "Box.renderShadow: bounds=%s shadow=%s",
+		bounds, area,
+	)
+
+	s := style.Empty()
+	fg := shadow.ForegroundColor()
+	if fg != nil {
+		s.SetForegroundColor(fg)
+	}
+	bg := shadow.BackgroundColor()
+	if bg != nil {
+		s.SetBackgroundColor(bg)
+	}
+	ts := style.TCell(s)
+
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		for x := area.Min.X; x < area.Max.X; x++ {
+			if (types.Point{X: x, Y: y}).In(bounds) {
+				continue
+			}
+			screen.Put(x, y, ch, ts)
+		}
+	}
+}
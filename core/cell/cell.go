@@ -29,6 +29,12 @@ func (c *Cell) Unstyled() bool {
 	return (c.style == nil || c.style.Unstyled())
 }
 
+// Specified returns true if the Cell's Style explicitly sets the supplied
+// Property.
+func (c *Cell) Specified(p types.Property) bool {
+	return c.style != nil && c.style.Specified(p)
+}
+
 // Empty returns true if the Cell has no content.
 func (c *Cell) Empty() bool {
 	return len(c.content) == 0 &&
@@ -172,6 +178,29 @@ func (c *Cell) WithBlink(on bool) types.Cell {
 	return c
 }
 
+// Reverse returns true if the Cell has its foreground and background colors
+// reversed.
+func (c *Cell) Reverse() bool {
+	if c.style == nil {
+		return false
+	}
+	return c.style.Reverse()
+}
+
+// SetReverse sets the Cell's reverse attribute.
+func (c *Cell) SetReverse(on bool) {
+	if c.style == nil {
+		c.style = style.Empty()
+	}
+	c.style.SetReverse(on)
+}
+
+// WithReverse sets the Cell's reverse attribute and returns the Cell
+func (c *Cell) WithReverse(on bool) types.Cell {
+	c.SetReverse(on)
+	return c
+}
+
 // Underline returns true if the Cell is underlined.
 func (c *Cell) Underline() bool {
 	if c.style == nil {
@@ -268,4 +297,26 @@ func (c *Cell) WithBackgroundColor(color types.Color) types.Cell {
 	return c
 }
 
+// Hyperlink returns the URL and ID of the Cell's OSC 8 hyperlink, if any.
+func (c *Cell) Hyperlink() (string, string) {
+	if c.style == nil {
+		return "", ""
+	}
+	return c.style.Hyperlink()
+}
+
+// SetHyperlink sets the URL and optional ID of the Cell's OSC 8 hyperlink.
+func (c *Cell) SetHyperlink(url, id string) {
+	if c.style == nil {
+		c.style = style.Empty()
+	}
+	c.style.SetHyperlink(url, id)
+}
+
+// WithHyperlink sets the Cell's OSC 8 hyperlink and returns the Cell.
+func (c *Cell) WithHyperlink(url, id string) types.Cell {
+	c.SetHyperlink(url, id)
+	return c
+}
+
 var _ types.Cell = (*Cell)(nil)
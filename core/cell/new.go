@@ -65,6 +65,21 @@ func WithBlink() types.CellWithOption {
 	}
 }
 
+// WithReverse enables the reverse attribute in the Cell.
+func WithReverse() types.CellWithOption {
+	return func(c types.Cell) {
+		c.SetReverse(true)
+	}
+}
+
+// WithHyperlink sets the types.Cell's OSC 8 hyperlink to the supplied URL and
+// optional ID.
+func WithHyperlink(url, id string) types.CellWithOption {
+	return func(c types.Cell) {
+		c.SetHyperlink(url, id)
+	}
+}
+
 // WithUnderlineStyle sets the types.Cell's underline style to the supplied
 // value.
 func WithUnderlineStyle(ulStyle types.UnderlineStyle) types.CellWithOption {
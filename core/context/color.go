@@ -0,0 +1,71 @@
+package context
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/muesli/termenv"
+
+	"github.com/jaypipes/gt/types"
+)
+
+const (
+	envKeyColorProfile = "GT_COLOR_PROFILE"
+)
+
+var (
+	colorProfileKey     = ContextKey("gt.color.profile")
+	defaultColorProfile = types.ColorProfileTrueColor
+)
+
+// WithColorProfile allows overriding the detected color profile of the
+// terminal. An Application created with the context maps colors to the
+// nearest color supported by the profile when drawing them. This is useful
+// in tests that need deterministic output.
+func WithColorProfile(profile types.ColorProfile) ContextModifier {
+	return func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, colorProfileKey, profile)
+	}
+}
+
+// ColorProfile gets a context's color profile. If none is set, the color
+// profile is detected from the environs using EnvOrDefaultColorProfile.
+func ColorProfile(ctx context.Context) types.ColorProfile {
+	if ctx != nil {
+		if v := ctx.Value(colorProfileKey); v != nil {
+			return v.(types.ColorProfile)
+		}
+	}
+	return EnvOrDefaultColorProfile()
+}
+
+// EnvOrDefaultColorProfile returns the color profile named by the
+// GT_COLOR_PROFILE environs variable ("truecolor", "ansi256", "ansi" or
+// "monochrome"), if set. Otherwise the color profile of the terminal is
+// detected from the TERM and COLORTERM environs variables. Setting the
+// NO_COLOR environs variable disables colors, resulting in the monochrome
+// profile.
+func EnvOrDefaultColorProfile() types.ColorProfile {
+	if cp, exists := os.LookupEnv(envKeyColorProfile); exists {
+		switch strings.ToLower(cp) {
+		case "truecolor", "24bit":
+			return types.ColorProfileTrueColor
+		case "ansi256", "256":
+			return types.ColorProfileANSI256
+		case "ansi", "16":
+			return types.ColorProfileANSI
+		case "monochrome", "mono", "none":
+			return types.ColorProfileMonochrome
+		}
+	}
+	switch termenv.EnvColorProfile() {
+	case termenv.Ascii:
+		return types.ColorProfileMonochrome
+	case termenv.ANSI:
+		return types.ColorProfileANSI
+	case termenv.ANSI256:
+		return types.ColorProfileANSI256
+	}
+	return defaultColorProfile
+}
@@ -27,5 +27,18 @@ func FromEnv() context.Context {
 	level := EnvOrDefaultLogLevel()
 	gtlog.SetLevel(level)
 	ctx = context.WithValue(ctx, logLevelKey, level)
+	ctx = context.WithValue(ctx, colorProfileKey, EnvOrDefaultColorProfile())
+	if name := EnvOrDefaultThemeName(); name != "" {
+		ctx = context.WithValue(ctx, themeNameKey, name)
+	}
+	if path := EnvOrDefaultRecordPath(); path != "" {
+		ctx = context.WithValue(ctx, recordPathKey, path)
+	}
+	if path := EnvOrDefaultReplayPath(); path != "" {
+		ctx = context.WithValue(ctx, replayPathKey, path)
+		ctx = context.WithValue(ctx, replaySpeedKey, EnvOrDefaultReplaySpeed())
+		ctx = context.WithValue(ctx, replayStepKey, EnvOrDefaultReplayStep())
+		ctx = context.WithValue(ctx, replayExitKey, EnvOrDefaultReplayExit())
+	}
 	return ctx
 }
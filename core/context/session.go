@@ -0,0 +1,166 @@
+package context
+
+import (
+	"context"
+	"os"
+	"strconv"
+)
+
+const (
+	envKeyRecord      = "GT_RECORD"
+	envKeyReplay      = "GT_REPLAY"
+	envKeyReplaySpeed = "GT_REPLAY_SPEED"
+	envKeyReplayStep  = "GT_REPLAY_STEP"
+	envKeyReplayExit  = "GT_REPLAY_EXIT"
+)
+
+var (
+	recordPathKey      = ContextKey("gt.session.record")
+	replayPathKey      = ContextKey("gt.session.replay")
+	replaySpeedKey     = ContextKey("gt.session.replay.speed")
+	replayStepKey      = ContextKey("gt.session.replay.step")
+	replayExitKey      = ContextKey("gt.session.replay.exit")
+	defaultReplaySpeed = 1.0
+)
+
+// WithRecordPath sets the path of the session file an Application records
+// every input event it receives to.
+func WithRecordPath(path string) ContextModifier {
+	return func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, recordPathKey, path)
+	}
+}
+
+// RecordPath gets a context's session recording path or the empty string if
+// none is set.
+func RecordPath(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	if v := ctx.Value(recordPathKey); v != nil {
+		return v.(string)
+	}
+	return ""
+}
+
+// EnvOrDefaultRecordPath returns the session recording path in the GT_RECORD
+// environs variable, if set. Otherwise returns the empty string.
+func EnvOrDefaultRecordPath() string {
+	return os.Getenv(envKeyRecord)
+}
+
+// WithReplayPath sets the path of a recorded session file whose input events
+// an Application replays instead of handling those from the terminal.
+func WithReplayPath(path string) ContextModifier {
+	return func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, replayPathKey, path)
+	}
+}
+
+// ReplayPath gets a context's session replay path or the empty string if none
+// is set.
+func ReplayPath(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	if v := ctx.Value(replayPathKey); v != nil {
+		return v.(string)
+	}
+	return ""
+}
+
+// EnvOrDefaultReplayPath returns the session replay path in the GT_REPLAY
+// environs variable, if set. Otherwise returns the empty string.
+func EnvOrDefaultReplayPath() string {
+	return os.Getenv(envKeyReplay)
+}
+
+// WithReplaySpeed sets how fast a session is replayed relative to the speed
+// it was recorded at. A speed of 0 replays events without delay.
+func WithReplaySpeed(speed float64) ContextModifier {
+	return func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, replaySpeedKey, speed)
+	}
+}
+
+// ReplaySpeed gets a context's session replay speed or the default of 1 if
+// none is set.
+func ReplaySpeed(ctx context.Context) float64 {
+	if ctx == nil {
+		return defaultReplaySpeed
+	}
+	if v := ctx.Value(replaySpeedKey); v != nil {
+		return v.(float64)
+	}
+	return defaultReplaySpeed
+}
+
+// EnvOrDefaultReplaySpeed returns the session replay speed in the
+// GT_REPLAY_SPEED environs variable, if set to a valid number. Otherwise
+// returns the default of 1.
+func EnvOrDefaultReplaySpeed() float64 {
+	if v, exists := os.LookupEnv(envKeyReplaySpeed); exists {
+		if speed, err := strconv.ParseFloat(v, 64); err == nil && speed >= 0 {
+			return speed
+		}
+	}
+	return defaultReplaySpeed
+}
+
+// WithReplayStep sets whether a replayed session advances one event per key
+// pressed in the terminal instead of at the recorded times.
+func WithReplayStep(on bool) ContextModifier {
+	return func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, replayStepKey, on)
+	}
+}
+
+// ReplayStep gets whether a context's replayed session is stepped through.
+func ReplayStep(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	if v := ctx.Value(replayStepKey); v != nil {
+		return v.(bool)
+	}
+	return false
+}
+
+// EnvOrDefaultReplayStep returns true if the GT_REPLAY_STEP environs variable
+// is set to a true value.
+func EnvOrDefaultReplayStep() bool {
+	return envBool(envKeyReplayStep)
+}
+
+// WithReplayExit sets whether an Application exits once it has replayed every
+// event of a session, e.g. when replaying on a headless screen.
+func WithReplayExit(on bool) ContextModifier {
+	return func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, replayExitKey, on)
+	}
+}
+
+// ReplayExit gets whether an Application exits once it has replayed every
+// event of a session.
+func ReplayExit(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	if v := ctx.Value(replayExitKey); v != nil {
+		return v.(bool)
+	}
+	return false
+}
+
+// EnvOrDefaultReplayExit returns true if the GT_REPLAY_EXIT environs variable
+// is set to a true value.
+func EnvOrDefaultReplayExit() bool {
+	return envBool(envKeyReplayExit)
+}
+
+// envBool returns true if the supplied environs variable is set to a true
+// value, e.g. "1" or "true".
+func envBool(key string) bool {
+	on, err := strconv.ParseBool(os.Getenv(key))
+	return err == nil && on
+}
@@ -0,0 +1,40 @@
+package context
+
+import (
+	"context"
+	"os"
+)
+
+const (
+	envKeyThemeName = "GT_THEME"
+)
+
+var (
+	themeNameKey = ContextKey("gt.theme.name")
+)
+
+// WithThemeName sets the name of the registered Theme an Application uses
+// when it has no Theme of its own. A "-dark" or "-light" suffix on the name
+// selects that variant of the Theme regardless of the terminal's background.
+func WithThemeName(name string) ContextModifier {
+	return func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, themeNameKey, name)
+	}
+}
+
+// ThemeName gets a context's Theme name or the empty string if none is set.
+func ThemeName(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	if v := ctx.Value(themeNameKey); v != nil {
+		return v.(string)
+	}
+	return ""
+}
+
+// EnvOrDefaultThemeName returns the Theme name in the GT_THEME environs
+// variable, if set. Otherwise returns the empty string.
+func EnvOrDefaultThemeName() string {
+	return os.Getenv(envKeyThemeName)
+}
@@ -36,6 +36,14 @@ func WithTCell(
 	}
 }
 
+// WithKey modifies the returned Event, setting its key combination to the
+// supplied Key.
+func WithKey(k types.Key) types.KeyPressEventWithOption {
+	return func(e types.KeyPressEvent) {
+		e.SetKey(k)
+	}
+}
+
 // WithSource modifies the returned Event, setting its source to the supplied
 // value.
 func WithSource(source any) types.KeyPressEventWithOption {
@@ -0,0 +1,31 @@
+package mouse
+
+import "github.com/jaypipes/gt/types"
+
+// NewPressEvent returns a PressEvent given the current mouse event and whether
+// the mouse button is held down or was released.
+func NewPressEvent(
+	ev types.MouseEvent,
+	pressed bool,
+) *PressEvent {
+	return &PressEvent{
+		MouseEvent: ev,
+		pressed:    pressed,
+	}
+}
+
+// PressEvent describes a mouse button being pressed or released.
+type PressEvent struct {
+	types.MouseEvent
+	// pressed is true if the mouse button is held down on the receiver of the
+	// event.
+	pressed bool
+}
+
+// Pressed returns true if the mouse button is held down on the receiver of
+// the event and false if it was released.
+func (e *PressEvent) Pressed() bool {
+	return e.pressed
+}
+
+var _ types.MousePressEvent = (*PressEvent)(nil)
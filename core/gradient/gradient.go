@@ -0,0 +1,143 @@
+package gradient
+
+import (
+	"sort"
+
+	"github.com/lucasb-eyer/go-colorful"
+
+	"github.com/jaypipes/gt/types"
+)
+
+// Gradient is a linear, multi-stop color gradient.
+type Gradient struct {
+	// direction is the direction along which the Gradient's colors change.
+	direction types.GradientDirection
+	// space is the color space in which colors are interpolated.
+	space types.ColorSpace
+	// stops are the Gradient's color stops, ordered by position.
+	stops []types.GradientStop
+}
+
+// Direction returns the direction along which the Gradient's colors change.
+func (g *Gradient) Direction() types.GradientDirection {
+	return g.direction
+}
+
+// SetDirection sets the direction along which the Gradient's colors change.
+func (g *Gradient) SetDirection(direction types.GradientDirection) {
+	g.direction = direction
+}
+
+// ColorSpace returns the color space in which the Gradient's colors are
+// interpolated.
+func (g *Gradient) ColorSpace() types.ColorSpace {
+	return g.space
+}
+
+// SetColorSpace sets the color space in which the Gradient's colors are
+// interpolated.
+func (g *Gradient) SetColorSpace(space types.ColorSpace) {
+	g.space = space
+}
+
+// Stops returns the Gradient's color stops, ordered by position.
+func (g *Gradient) Stops() []types.GradientStop {
+	return g.stops
+}
+
+// AddStop adds a color stop to the Gradient at the supplied position. The
+// position is clamped to the range [0, 1].
+func (g *Gradient) AddStop(pos float64, color types.Color) {
+	g.stops = append(g.stops, types.GradientStop{
+		Position: clamp(pos),
+		Color:    color,
+	})
+	sort.SliceStable(g.stops, func(i, j int) bool {
+		return g.stops[i].Position < g.stops[j].Position
+	})
+}
+
+// At returns the interpolated color at the supplied position, from 0 (the
+// start) to 1 (the end), along the Gradient. At returns nil if the Gradient
+// has no stops.
+func (g *Gradient) At(pos float64) types.Color {
+	if len(g.stops) == 0 {
+		return nil
+	}
+	pos = clamp(pos)
+	first := g.stops[0]
+	if pos <= first.Position {
+		return first.Color
+	}
+	last := g.stops[len(g.stops)-1]
+	if pos >= last.Position {
+		return last.Color
+	}
+	for x := 1; x < len(g.stops); x++ {
+		to := g.stops[x]
+		if pos > to.Position {
+			continue
+		}
+		from := g.stops[x-1]
+		span := to.Position - from.Position
+		if span <= 0 {
+			return to.Color
+		}
+		return g.blend(from.Color, to.Color, (pos-from.Position)/span)
+	}
+	return last.Color
+}
+
+// Along returns the color of the cell at the supplied point within the
+// supplied area, according to the Gradient's direction.
+func (g *Gradient) Along(pt types.Point, area types.Rectangle) types.Color {
+	switch g.direction {
+	case types.GradientVertical:
+		return g.At(position(pt.Y-area.Min.Y, area.Dy()))
+	default:
+		return g.At(position(pt.X-area.Min.X, area.Dx()))
+	}
+}
+
+// blend interpolates between the two supplied colors in the Gradient's color
+// space.
+func (g *Gradient) blend(from, to types.Color, t float64) types.Color {
+	c1, _ := colorful.MakeColor(from)
+	c2, _ := colorful.MakeColor(to)
+	var c colorful.Color
+	switch g.space {
+	case types.ColorSpaceLinearRGB:
+		c = c1.BlendLinearRgb(c2, t)
+	case types.ColorSpaceHSV:
+		c = c1.BlendHsv(c2, t)
+	case types.ColorSpaceLab:
+		c = c1.BlendLab(c2, t)
+	case types.ColorSpaceLuv:
+		c = c1.BlendLuv(c2, t)
+	case types.ColorSpaceHCL:
+		c = c1.BlendHcl(c2, t)
+	case types.ColorSpaceOkLab:
+		c = c1.BlendOkLab(c2, t)
+	case types.ColorSpaceOkLch:
+		c = c1.BlendOkLch(c2, t)
+	default:
+		c = c1.BlendRgb(c2, t)
+	}
+	return c.Clamped()
+}
+
+// position returns the position, in the range [0, 1], of the supplied offset
+// along a line of the supplied length.
+func position(offset int, length int) float64 {
+	if length <= 1 {
+		return 0
+	}
+	return float64(offset) / float64(length-1)
+}
+
+// clamp clamps the supplied value to the range [0, 1].
+func clamp(v float64) float64 {
+	return min(max(v, 0), 1)
+}
+
+var _ types.Gradient = (*Gradient)(nil)
@@ -0,0 +1,55 @@
+package gradient
+
+import (
+	"github.com/jaypipes/gt/types"
+)
+
+// New returns a new horizontal Gradient that interpolates colors in the sRGB
+// color space.
+//
+// You can pass zero or more GradientWithOptions to optionally set certain
+// attributes on the returned Gradient.
+func New(opts ...types.GradientWithOption) types.Gradient {
+	g := &Gradient{}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
+// WithDirection sets the types.Gradient's direction to the supplied value.
+func WithDirection(direction types.GradientDirection) types.GradientWithOption {
+	return func(g types.Gradient) {
+		g.SetDirection(direction)
+	}
+}
+
+// WithColorSpace sets the color space the types.Gradient interpolates colors
+// in to the supplied value.
+func WithColorSpace(space types.ColorSpace) types.GradientWithOption {
+	return func(g types.Gradient) {
+		g.SetColorSpace(space)
+	}
+}
+
+// WithStop adds a color stop at the supplied position, from 0 (the start) to 1
+// (the end), to the types.Gradient.
+func WithStop(pos float64, color types.Color) types.GradientWithOption {
+	return func(g types.Gradient) {
+		g.AddStop(pos, color)
+	}
+}
+
+// WithColors adds color stops for each of the supplied colors, evenly spaced
+// along the types.Gradient.
+func WithColors(colors ...types.Color) types.GradientWithOption {
+	return func(g types.Gradient) {
+		if len(colors) == 1 {
+			g.AddStop(0, colors[0])
+			return
+		}
+		for x, c := range colors {
+			g.AddStop(float64(x)/float64(len(colors)-1), c)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package graphic
+
+// lineWeight is the weight of a line in a box drawing character.
+type lineWeight uint8
+
+const (
+	weightNone lineWeight = iota
+	weightLight
+	weightHeavy
+	weightDouble
+)
+
+// boxArms are the weights of the lines leading up, right, down and left from
+// the center of a box drawing character.
+type boxArms [4]lineWeight
+
+const (
+	armUp = iota
+	armRight
+	armDown
+	armLeft
+)
+
+// boxDrawingArms maps the line drawing characters in the Box Drawing block to
+// their arms. Dashed lines and arcs map to the arms of the plain lines and
+// corners they resemble.
+var boxDrawingArms = map[rune]boxArms{
+	BoxDrawingLightHorizontal:                   {weightNone, weightLight, weightNone, weightLight},
+	BoxDrawingHeavyHorizontal:                   {weightNone, weightHeavy, weightNone, weightHeavy},
+	BoxDrawingLightVertical:                     {weightLight, weightNone, weightLight, weightNone},
+	BoxDrawingHeavyVertical:                     {weightHeavy, weightNone, weightHeavy, weightNone},
+	BoxDrawingLightTripleDashHorizontal:         {weightNone, weightLight, weightNone, weightLight},
+	BoxDrawingHeavyTripleDashHorizontal:         {weightNone, weightHeavy, weightNone, weightHeavy},
+	BoxDrawingLightTripleDashVertical:           {weightLight, weightNone, weightLight, weightNone},
+	BoxDrawingHeavyTripleDashVertical:           {weightHeavy, weightNone, weightHeavy, weightNone},
+	BoxDrawingLightQuadrupleDashHorizontal:      {weightNone, weightLight, weightNone, weightLight},
+	BoxDrawingHeavyQuadrupleDashHorizontal:      {weightNone, weightHeavy, weightNone, weightHeavy},
+	BoxDrawingLightQuadrupleDashVertical:        {weightLight, weightNone, weightLight, weightNone},
+	BoxDrawingHeavyQuadrupleDashVertical:        {weightHeavy, weightNone, weightHeavy, weightNone},
+	BoxDrawingLightDownAndRight:                 {weightNone, weightLight, weightLight, weightNone},
+	BoxDrawingDownLightAndRightHeavy:            {weightNone, weightHeavy, weightLight, weightNone},
+	BoxDrawingDownHeavyAndRightLight:            {weightNone, weightLight, weightHeavy, weightNone},
+	BoxDrawingHeavyDownAndRight:                 {weightNone, weightHeavy, weightHeavy, weightNone},
+	BoxDrawingLightDownAndLeft:                  {weightNone, weightNone, weightLight, weightLight},
+	BoxDrawingDownLightAndLeftHeavy:             {weightNone, weightNone, weightLight, weightHeavy},
+	BoxDrawingDownHeavyAndLeftLight:             {weightNone, weightNone, weightHeavy, weightLight},
+	BoxDrawingHeavyDownAndLeft:                  {weightNone, weightNone, weightHeavy, weightHeavy},
+	BoxDrawingLightUpAndRight:                   {weightLight, weightLight, weightNone, weightNone},
+	BoxDrawingUpLightAndRightHeavy:              {weightLight, weightHeavy, weightNone, weightNone},
+	BoxDrawingUpHeavyAndRightLight:              {weightHeavy, weightLight, weightNone, weightNone},
+	BoxDrawingHeavyUpAndRight:                   {weightHeavy, weightHeavy, weightNone, weightNone},
+	BoxDrawingLightUpAndLeft:                    {weightLight, weightNone, weightNone, weightLight},
+	BoxDrawingUpLightAndLeftHeavy:               {weightLight, weightNone, weightNone, weightHeavy},
+	BoxDrawingUpHeavyAndLeftLight:               {weightHeavy, weightNone, weightNone, weightLight},
+	BoxDrawingHeavyUpAndLeft:                    {weightHeavy, weightNone, weightNone, weightHeavy},
+	BoxDrawingLightVerticalAndRight:             {weightLight, weightLight, weightLight, weightNone},
+	BoxDrawingVerticalLightAndRightHeavy:        {weightLight, weightHeavy, weightLight, weightNone},
+	BoxDrawingUpHeavyAndRightDownLight:          {weightHeavy, weightLight, weightLight, weightNone},
+	BoxDrawingDownHeavyAndRightUpLight:          {weightLight, weightLight, weightHeavy, weightNone},
+	BoxDrawingVerticalHeavyAndRightLight:        {weightHeavy, weightLight, weightHeavy, weightNone},
+	BoxDrawingDownLightAndRightUpHeavy:          {weightHeavy, weightHeavy, weightLight, weightNone},
+	BoxDrawingUpLightAndRightDownHeavy:          {weightLight, weightHeavy, weightHeavy, weightNone},
+	BoxDrawingHeavyVerticalAndRight:             {weightHeavy, weightHeavy, weightHeavy, weightNone},
+	BoxDrawingLightVerticalAndLeft:              {weightLight, weightNone, weightLight, weightLight},
+	BoxDrawingVerticalLightAndLeftHeavy:         {weightLight, weightNone, weightLight, weightHeavy},
+	BoxDrawingUpHeavyAndLeftDownLight:           {weightHeavy, weightNone, weightLight, weightLight},
+	BoxDrawingDownHeavyAndLeftUpLight:           {weightLight, weightNone, weightHeavy, weightLight},
+	BoxDrawingVerticalHeavyAndLeftLight:         {weightHeavy, weightNone, weightHeavy, weightLight},
+	BoxDrawingDownLightAndLeftUpHeavy:           {weightHeavy, weightNone, weightLight, weightHeavy},
+	BoxDrawingUpLightAndLeftDownHeavy:           {weightLight, weightNone, weightHeavy, weightHeavy},
+	BoxDrawingHeavyVerticalAndLeft:              {weightHeavy, weightNone, weightHeavy, weightHeavy},
+	BoxDrawingLightDownAndHorizontal:            {weightNone, weightLight, weightLight, weightLight},
+	BoxDrawingLeftHeavyAndRightDownLight:        {weightNone, weightLight, weightLight, weightHeavy},
+	BoxDrawingRightHeavyAndLeftDownLight:        {weightNone, weightHeavy, weightLight, weightLight},
+	BoxDrawingDownLightAndHorizontalHeavy:       {weightNone, weightHeavy, weightLight, weightHeavy},
+	BoxDrawingDownHeavyAndHorizontalLight:       {weightNone, weightLight, weightHeavy, weightLight},
+	BoxDrawingRightLightAndLeftDownHeavy:        {weightNone, weightLight, weightHeavy, weightHeavy},
+	BoxDrawingLeftLightAndRightDownHeavy:        {weightNone, weightHeavy, weightHeavy, weightLight},
+	BoxDrawingHeavyDownAndHorizontal:            {weightNone, weightHeavy, weightHeavy, weightHeavy},
+	BoxDrawingLightUpAndHorizontal:              {weightLight, weightLight, weightNone, weightLight},
+	BoxDrawingLeftHeavyAndRightUpLight:          {weightLight, weightLight, weightNone, weightHeavy},
+	BoxDrawingRightHeavyAndLeftUpLight:          {weightLight, weightHeavy, weightNone, weightLight},
+	BoxDrawingUpLightAndHorizontalHeavy:         {weightLight, weightHeavy, weightNone, weightHeavy},
+	BoxDrawingUpHeavyAndHorizontalLight:         {weightHeavy, weightLight, weightNone, weightLight},
+	BoxDrawingRightLightAndLeftUpHeavy:          {weightHeavy, weightLight, weightNone, weightHeavy},
+	BoxDrawingLeftLightAndRightUpHeavy:          {weightHeavy, weightHeavy, weightNone, weightLight},
+	BoxDrawingHeavyUpAndHorizontal:              {weightHeavy, weightHeavy, weightNone, weightHeavy},
+	BoxDrawingLightVerticalAndHorizontal:        {weightLight, weightLight, weightLight, weightLight},
+	BoxDrawingLeftHeavyAndRightVerticalLight:    {weightLight, weightLight, weightLight, weightHeavy},
+	BoxDrawingRightHeavyAndLeftVerticalLight:    {weightLight, weightHeavy, weightLight, weightLight},
+	BoxDrawingVerticalLightAndHorizontalHeavy:   {weightLight, weightHeavy, weightLight, weightHeavy},
+	BoxDrawingUpHeavyAndDownHorizontalLight:     {weightHeavy, weightLight, weightLight, weightLight},
+	BoxDrawingDownHeavyAndUpHorizontalLight:     {weightLight, weightLight, weightHeavy, weightLight},
+	BoxDrawingVerticalHeavyAndHorizontalLight:   {weightHeavy, weightLight, weightHeavy, weightLight},
+	BoxDrawingLeftUpHeavyAndRightDownLight:      {weightHeavy, weightLight, weightLight, weightHeavy},
+	BoxDrawingRightUpHeavyAndLeftDownLight:      {weightHeavy, weightHeavy, weightLight, weightLight},
+	BoxDrawingLeftDownHeavyAndRightUpLight:      {weightLight, weightLight, weightHeavy, weightHeavy},
+	BoxDrawingRightDownHeavyAndLeftUpLight:      {weightLight, weightHeavy, weightHeavy, weightLight},
+	BoxDrawingDownLightAndUpHorizontalHeavy:     {weightHeavy, weightHeavy, weightLight, weightHeavy},
+	BoxDrawingUpLightAndDownHorizontalHeavy:     {weightLight, weightHeavy, weightHeavy, weightHeavy},
+	BoxDrawingRightLightAndLeftVerticalHeavy:    {weightHeavy, weightLight, weightHeavy, weightHeavy},
+	BoxDrawingLeftLightAndRightVerticalHeavy:    {weightHeavy, weightHeavy, weightHeavy, weightLight},
+	BoxDrawingHeavyVerticalAndHorizontal:        {weightHeavy, weightHeavy, weightHeavy, weightHeavy},
+	BoxDrawingLightDoubleDashHorizontal:         {weightNone, weightLight, weightNone, weightLight},
+	BoxDrawingHeavyDoubleDashHorizontal:         {weightNone, weightHeavy, weightNone, weightHeavy},
+	BoxDrawingLightDoubleDashVertical:           {weightLight, weightNone, weightLight, weightNone},
+	BoxDrawingHeavyDoubleDashVertical:           {weightHeavy, weightNone, weightHeavy, weightNone},
+	BoxDrawingDoubleHorizontal:                  {weightNone, weightDouble, weightNone, weightDouble},
+	BoxDrawingDoubleVertical:                    {weightDouble, weightNone, weightDouble, weightNone},
+	BoxDrawingDownSingleAndRightDouble:          {weightNone, weightDouble, weightLight, weightNone},
+	BoxDrawingDownDoubleAndRightSingle:          {weightNone, weightLight, weightDouble, weightNone},
+	BoxDrawingDoubleDownAndRight:                {weightNone, weightDouble, weightDouble, weightNone},
+	BoxDrawingDownSingleAndLeftDouble:           {weightNone, weightNone, weightLight, weightDouble},
+	BoxDrawingDownDoubleAndLeftSingle:           {weightNone, weightNone, weightDouble, weightLight},
+	BoxDrawingDoubleDownAndLeft:                 {weightNone, weightNone, weightDouble, weightDouble},
+	BoxDrawingUpSingleAndRightDouble:            {weightLight, weightDouble, weightNone, weightNone},
+	BoxDrawingUpDoubleAndRightSingle:            {weightDouble, weightLight, weightNone, weightNone},
+	BoxDrawingDoubleUpAndRight:                  {weightDouble, weightDouble, weightNone, weightNone},
+	BoxDrawingUpSingleAndLeftDouble:             {weightLight, weightNone, weightNone, weightDouble},
+	BoxDrawingUpDoubleAndLeftSingle:             {weightDouble, weightNone, weightNone, weightLight},
+	BoxDrawingDoubleUpAndLeft:                   {weightDouble, weightNone, weightNone, weightDouble},
+	BoxDrawingVerticalSingleAndRightDouble:      {weightLight, weightDouble, weightLight, weightNone},
+	BoxDrawingVerticalDoubleAndRightSingle:      {weightDouble, weightLight, weightDouble, weightNone},
+	BoxDrawingDoubleVerticalAndRight:            {weightDouble, weightDouble, weightDouble, weightNone},
+	BoxDrawingVerticalSingleAndLeftDouble:       {weightLight, weightNone, weightLight, weightDouble},
+	BoxDrawingVerticalDoubleAndLeftSingle:       {weightDouble, weightNone, weightDouble, weightLight},
+	BoxDrawingDoubleVerticalAndLeft:             {weightDouble, weightNone, weightDouble, weightDouble},
+	BoxDrawingDownSingleAndHorizontalDouble:     {weightNone, weightDouble, weightLight, weightDouble},
+	BoxDrawingDownDoubleAndHorizontalSingle:     {weightNone, weightLight, weightDouble, weightLight},
+	BoxDrawingDoubleDownAndHorizontal:           {weightNone, weightDouble, weightDouble, weightDouble},
+	BoxDrawingUpSingleAndHorizontalDouble:       {weightLight, weightDouble, weightNone, weightDouble},
+	BoxDrawingUpDoubleAndHorizontalSingle:       {weightDouble, weightLight, weightNone, weightLight},
+	BoxDrawingDoubleUpAndHorizontal:             {weightDouble, weightDouble, weightNone, weightDouble},
+	BoxDrawingVerticalSingleAndHorizontalDouble: {weightLight, weightDouble, weightLight, weightDouble},
+	BoxDrawingVerticalDoubleAndHorizontalSingle: {weightDouble, weightLight, weightDouble, weightLight},
+	BoxDrawingDoubleVerticalAndHorizontal:       {weightDouble, weightDouble, weightDouble, weightDouble},
+	BoxDrawingLightArcDownAndRight:              {weightNone, weightLight, weightLight, weightNone},
+	BoxDrawingLightArcDownAndLeft:               {weightNone, weightNone, weightLight, weightLight},
+	BoxDrawingLightArcUpAndLeft:                 {weightLight, weightNone, weightNone, weightLight},
+	BoxDrawingLightArcUpAndRight:                {weightLight, weightLight, weightNone, weightNone},
+	BoxDrawingLightLeft:                         {weightNone, weightNone, weightNone, weightLight},
+	BoxDrawingLightUp:                           {weightLight, weightNone, weightNone, weightNone},
+	BoxDrawingLightRight:                        {weightNone, weightLight, weightNone, weightNone},
+	BoxDrawingLightDown:                         {weightNone, weightNone, weightLight, weightNone},
+	BoxDrawingHeavyLeft:                         {weightNone, weightNone, weightNone, weightHeavy},
+	BoxDrawingHeavyUp:                           {weightHeavy, weightNone, weightNone, weightNone},
+	BoxDrawingHeavyRight:                        {weightNone, weightHeavy, weightNone, weightNone},
+	BoxDrawingHeavyDown:                         {weightNone, weightNone, weightHeavy, weightNone},
+	BoxDrawingLightLeftAndHeavyRight:            {weightNone, weightHeavy, weightNone, weightLight},
+	BoxDrawingLightUpAndHeavyDown:               {weightLight, weightNone, weightHeavy, weightNone},
+	BoxDrawingHeavyLeftAndLightRight:            {weightNone, weightLight, weightNone, weightHeavy},
+	BoxDrawingHeavyUpAndLightDown:               {weightHeavy, weightNone, weightLight, weightNone},
+}
+
+// boxDrawingRunes maps arms to the line drawing character having them,
+// preferring plain lines and corners over dashed lines and arcs.
+var boxDrawingRunes = func() map[boxArms]rune {
+	runes := make(map[boxArms]rune, len(boxDrawingArms))
+	// Walk the block in order so that plain lines and corners, which come
+	// before the dashed lines and arcs having the same arms, win.
+	for r := BoxDrawingLightHorizontal; r <= BoxDrawingHeavyUpAndLightDown; r++ {
+		arms, ok := boxDrawingArms[r]
+		if !ok {
+			continue
+		}
+		if _, exists := runes[arms]; !exists {
+			runes[arms] = r
+		}
+	}
+	return runes
+}()
+
+// JoinBoxDrawing returns the box drawing character that joins the lines of
+// the two supplied box drawing characters, e.g. (┐) + (┌) = (┬) and (║) + (─)
+// = (╫). Each line takes the heavier of the two weights. Where no character
+// combines the resulting weights, the closest character is used.
+//
+// Returns false if either rune is not a line drawing character.
+func JoinBoxDrawing(a, b rune) (rune, bool) {
+	aa, ok := boxDrawingArms[a]
+	if !ok {
+		return b, false
+	}
+	ba, ok := boxDrawingArms[b]
+	if !ok {
+		return b, false
+	}
+	var arms boxArms
+	for x := range arms {
+		arms[x] = max(aa[x], ba[x])
+	}
+	return boxDrawingRune(arms), true
+}
+
+// BoxDrawingCorner returns the box drawing character for a corner joining
+// the lines of the supplied horizontal and vertical edge characters, e.g. (━)
+// and (│) give (┍) for a top left corner. The corner's horizontal line leads
+// right if right is true, or left otherwise, and its vertical line leads down
+// if down is true, or up otherwise. Dashed edges give plain corners.
+//
+// Returns false if either rune is not a line drawing character with a line
+// along its axis.
+func BoxDrawingCorner(
+	horizontal, vertical rune,
+	right, down bool,
+) (rune, bool) {
+	ha, ok := boxDrawingArms[horizontal]
+	if !ok {
+		return 0, false
+	}
+	va, ok := boxDrawingArms[vertical]
+	if !ok {
+		return 0, false
+	}
+	h, v := armLeft, armUp
+	if right {
+		h = armRight
+	}
+	if down {
+		v = armDown
+	}
+	var arms boxArms
+	arms[h] = max(ha[armLeft], ha[armRight])
+	arms[v] = max(va[armUp], va[armDown])
+	if arms[h] == weightNone || arms[v] == weightNone {
+		return 0, false
+	}
+	return boxDrawingRune(arms), true
+}
+
+// boxDrawingRune returns the line drawing character having the supplied arms.
+// Where no character has them, heavy lines are drawn light next to double
+// lines, then each axis takes a single weight, then all lines are drawn
+// light.
+func boxDrawingRune(arms boxArms) rune {
+	if r, ok := boxDrawingRunes[arms]; ok {
+		return r
+	}
+	// There are no characters mixing heavy and double lines.
+	for x := range arms {
+		if arms[x] == weightHeavy {
+			arms[x] = weightLight
+		}
+	}
+	if r, ok := boxDrawingRunes[arms]; ok {
+		return r
+	}
+	// Characters mixing light and double lines use one weight per axis.
+	for _, axis := range [][2]int{{armUp, armDown}, {armLeft, armRight}} {
+		w := max(arms[axis[0]], arms[axis[1]])
+		for _, x := range axis {
+			if arms[x] != weightNone {
+				arms[x] = w
+			}
+		}
+	}
+	if r, ok := boxDrawingRunes[arms]; ok {
+		return r
+	}
+	for x := range arms {
+		if arms[x] != weightNone {
+			arms[x] = weightLight
+		}
+	}
+	return boxDrawingRunes[arms]
+}
@@ -2,7 +2,7 @@ package key
 
 import (
 	"fmt"
-	"strconv"
+	"strings"
 
 	"github.com/jaypipes/gt/core"
 	"github.com/jaypipes/gt/types"
@@ -14,18 +14,44 @@ type Key struct {
 	core.KeyModifiable
 	// code is the types.KeyCode representing the key pressed.
 	code types.KeyCode
+	// prefix contains the key press combinations pressed, in order, before
+	// this one when the Key is a sequence.
+	prefix []types.Key
 }
 
-// String returns a string representation of the Key.
+// String returns the canonical string representation of the Key, e.g.
+// "ctrl+alt+p", "G" or "escape", which [Parse] returns an equal Key for. The
+// key press combinations of a sequence are separated by spaces.
 func (k *Key) String() string {
+	if len(k.prefix) > 0 {
+		parts := make([]string, 0, len(k.prefix)+1)
+		for _, pk := range k.prefix {
+			parts = append(parts, pk.String())
+		}
+		parts = append(parts, k.last().String())
+		return strings.Join(parts, " ")
+	}
+	name := k.name()
 	mods := k.Modifiers().String()
-	if len(mods) > 0 {
-		mods += "+"
+	if len(mods) == 0 {
+		return name
 	}
-	if !k.code.Printable() {
-		return nonPrintableKeyCodeToString[k.code]
+	return mods + "+" + name
+}
+
+// name returns the canonical name of the Key's code, which is the lowercased
+// name of a non-printable key, "space" for the space key or the printable
+// character itself.
+func (k *Key) name() string {
+	switch {
+	case k.code == types.KeyCodeNUL:
+		return ""
+	case !k.code.Printable():
+		return strings.ToLower(nonPrintableKeyCodeToString[k.code])
+	case k.code == ' ':
+		return "space"
 	}
-	return mods + strconv.QuoteRune(rune(k.code))
+	return string(rune(k.code))
 }
 
 // Code returns the types.KeyCode for the Key.
@@ -38,8 +64,45 @@ func (k *Key) Printable() bool {
 	return k.code.Printable()
 }
 
-// Equal returns true if the Key matches the other Key.
+// Equal returns true if the Key matches the other Key. Sequences are equal
+// if all of their key press combinations are equal.
 func (k *Key) Equal(other types.Key) bool {
-	return k.Modifiers() == other.Modifiers() &&
-		k.code == other.Code()
+	if other == nil {
+		return false
+	}
+	if k.Modifiers() != other.Modifiers() || k.code != other.Code() {
+		return false
+	}
+	otherSeq := other.Sequence()
+	if len(otherSeq) != len(k.prefix)+1 {
+		return false
+	}
+	for x, pk := range k.prefix {
+		if !pk.Equal(otherSeq[x]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Sequence returns the key press combinations that must be pressed in order
+// to actuate the Key.
+func (k *Key) Sequence() []types.Key {
+	if len(k.prefix) == 0 {
+		return []types.Key{k}
+	}
+	seq := make([]types.Key, 0, len(k.prefix)+1)
+	seq = append(seq, k.prefix...)
+	return append(seq, k.last())
+}
+
+// last returns the last key press combination of the Key, without any
+// prefix.
+func (k *Key) last() *Key {
+	if len(k.prefix) == 0 {
+		return k
+	}
+	l := &Key{code: k.code}
+	l.SetModifiers(k.Modifiers())
+	return l
 }
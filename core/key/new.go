@@ -8,6 +8,9 @@ import (
 
 // New returns a new [types.Key] from either a string, [types.KeyCode],
 // [tcell.Key] or [tcell.EventKey]
+//
+// A string is parsed with [FromString] and is case-sensitive, so New("Q")
+// binds Shift+Q rather than "q".
 func New(subject any) *Key {
 	switch subject := subject.(type) {
 	case *Key:
@@ -0,0 +1,201 @@
+package key
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jaypipes/gt/types"
+)
+
+var (
+	// modifierAliases maps the lowercased names of modifier keys to their
+	// KeyModifiers bit.
+	modifierAliases = map[string]types.KeyModifiers{
+		"shift":   types.KeyModifierShift,
+		"ctrl":    types.KeyModifierCtrl,
+		"control": types.KeyModifierCtrl,
+		"alt":     types.KeyModifierAlt,
+		"opt":     types.KeyModifierAlt,
+		"option":  types.KeyModifierAlt,
+		"meta":    types.KeyModifierMeta,
+		"super":   types.KeyModifierSuper,
+		"cmd":     types.KeyModifierSuper,
+		"win":     types.KeyModifierSuper,
+		"hyper":   types.KeyModifierHyper,
+	}
+	// emacsModifiers maps the prefixes of Emacs-style key notation, e.g.
+	// "C-x", to their KeyModifiers bit. Terminals send the Meta key as Alt,
+	// so "M-" means Alt.
+	emacsModifiers = map[byte]types.KeyModifiers{
+		'C': types.KeyModifierCtrl,
+		'M': types.KeyModifierAlt,
+		'A': types.KeyModifierAlt,
+		'S': types.KeyModifierShift,
+		's': types.KeyModifierSuper,
+		'H': types.KeyModifierHyper,
+	}
+	// keyAliases maps lowercased alternative names of keys to their KeyCode.
+	keyAliases = map[string]types.KeyCode{
+		"esc":      types.KeyCodeEscape,
+		"return":   types.KeyCodeEnter,
+		"ret":      types.KeyCodeEnter,
+		"del":      types.KeyCodeDelete,
+		"bs":       types.KeyCodeBackspace,
+		"ins":      types.KeyCodeInsert,
+		"pageup":   types.KeyCodePgUp,
+		"pagedown": types.KeyCodePgDn,
+		"space":    ' ',
+		"spc":      ' ',
+	}
+)
+
+// Parse returns a [types.Key] from a string, or an error describing why the
+// string is not a valid key press combination.
+//
+// A key press combination is a key name, optionally preceded by modifiers
+// joined with "+", e.g. "a", "ctrl+alt+p", "shift+tab" or "ctrl++". The
+// modifiers are shift, ctrl, alt, meta, super and hyper. Emacs-style
+// notation such as "C-x" or "C-M-x" is also accepted. Named keys like "Enter"
+// and modifiers are case-insensitive and have common aliases, e.g. "esc",
+// "return", "del" and "space". A single printable character keeps its case,
+// so "G" is a different key than "g", and "shift+g" is the same as "G".
+//
+// Space-separated key press combinations, e.g. "g g" or "ctrl+x ctrl+s",
+// return a Key that is a sequence of those combinations.
+//
+// The String method of the returned Key is its canonical form, which Parse
+// returns the same Key for.
+func Parse(subject string) (*Key, error) {
+	fields := strings.Fields(subject)
+	switch len(fields) {
+	case 0:
+		if subject == " " {
+			return &Key{code: ' '}, nil
+		}
+		return nil, fmt.Errorf("empty key")
+	case 1:
+		return parseCombination(fields[0])
+	}
+	keys := make([]types.Key, 0, len(fields))
+	for _, field := range fields {
+		k, err := parseCombination(field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key sequence %q: %w", subject, err)
+		}
+		keys = append(keys, k)
+	}
+	return Sequence(keys...), nil
+}
+
+// MustParse returns a [types.Key] from a string, panicking if the string is
+// not a valid key press combination.
+func MustParse(subject string) *Key {
+	k, err := Parse(subject)
+	if err != nil {
+		panic(err)
+	}
+	return k
+}
+
+// parseCombination returns the Key for a single key press combination.
+func parseCombination(subject string) (*Key, error) {
+	mods, final, err := splitModifiers(subject)
+	if err != nil {
+		return nil, err
+	}
+	lower := strings.ToLower(final)
+	if _, ok := modifierAliases[lower]; ok {
+		return nil, fmt.Errorf("key %q ends with a modifier", subject)
+	}
+	var code types.KeyCode
+	if kc, ok := keyAliases[lower]; ok {
+		code = kc
+	} else if kc, ok := nonPrintableStringToKeyCode[lower]; ok {
+		code = kc
+	} else if utf8.RuneCountInString(final) == 1 {
+		r, _ := utf8.DecodeRuneInString(final)
+		code = types.KeyCode(r)
+	} else if final == subject {
+		return nil, fmt.Errorf("unknown key %q", subject)
+	} else {
+		return nil, fmt.Errorf("unknown key %q in %q", final, subject)
+	}
+	code, mods = normalize(code, mods)
+	k := &Key{code: code}
+	k.SetModifiers(mods)
+	return k, nil
+}
+
+// splitModifiers splits a single key press combination into its modifiers
+// and the name of its final key.
+func splitModifiers(subject string) (types.KeyModifiers, string, error) {
+	mods := types.KeyModifiers(0)
+	// Emacs-style notation, e.g. "C-x", "C-M-x" or "C--"
+	if len(subject) > 2 && subject[1] == '-' {
+		if _, ok := emacsModifiers[subject[0]]; ok {
+			rest := subject
+			for len(rest) > 2 && rest[1] == '-' {
+				mod, ok := emacsModifiers[rest[0]]
+				if !ok {
+					return 0, "", fmt.Errorf(
+						"unknown modifier %q in %q", rest[:2], subject,
+					)
+				}
+				mods |= mod
+				rest = rest[2:]
+			}
+			return mods, rest, nil
+		}
+	}
+	final := subject
+	rest := ""
+	switch {
+	case subject == "+":
+	case strings.HasSuffix(subject, "++"):
+		final = "+"
+		rest = subject[:len(subject)-2]
+	default:
+		if idx := strings.LastIndex(subject, "+"); idx >= 0 {
+			final = subject[idx+1:]
+			rest = subject[:idx]
+			if final == "" {
+				return 0, "", fmt.Errorf(
+					"missing key after modifiers in %q", subject,
+				)
+			}
+		}
+	}
+	if rest == "" {
+		return mods, final, nil
+	}
+	for _, name := range strings.Split(rest, "+") {
+		mod, ok := modifierAliases[strings.ToLower(name)]
+		if !ok {
+			return 0, "", fmt.Errorf(
+				"unknown modifier %q in %q", name, subject,
+			)
+		}
+		mods |= mod
+	}
+	return mods, final, nil
+}
+
+// normalize returns the KeyCode and KeyModifiers that a terminal reports for
+// the supplied KeyCode and KeyModifiers. Terminals report Shift+Tab as
+// Backtab, Shift with a letter as the uppercase letter, and Ctrl with a
+// letter as the lowercase letter.
+func normalize(
+	code types.KeyCode,
+	mods types.KeyModifiers,
+) (types.KeyCode, types.KeyModifiers) {
+	switch {
+	case code == types.KeyCodeTab && mods.Shift():
+		return types.KeyCodeBacktab, mods &^ types.KeyModifierShift
+	case mods.Ctrl() && code >= 'A' && code <= 'Z':
+		return code + 'a' - 'A', mods
+	case mods.Shift() && !mods.Ctrl() && code >= 'a' && code <= 'z':
+		return code - 'a' + 'A', mods &^ types.KeyModifierShift
+	}
+	return code, mods
+}
@@ -0,0 +1,47 @@
+package key
+
+import (
+	"github.com/jaypipes/gt/types"
+)
+
+// Sequence returns a new Key that is actuated by pressing the supplied keys
+// in order, e.g. Sequence(New("ctrl+x"), New("ctrl+s")). Any supplied Keys
+// that are themselves sequences are flattened into the returned Key.
+func Sequence(keys ...types.Key) *Key {
+	seq := []types.Key{}
+	for _, k := range keys {
+		if k != nil {
+			seq = append(seq, k.Sequence()...)
+		}
+	}
+	if len(seq) == 0 {
+		return &Key{}
+	}
+	last := seq[len(seq)-1]
+	k := &Key{code: last.Code()}
+	k.SetModifiers(last.Modifiers())
+	if len(seq) > 1 {
+		k.prefix = seq[:len(seq)-1]
+	}
+	return k
+}
+
+// HasPrefix returns true if the supplied Key is a sequence that begins with,
+// and is longer than, the supplied prefix. For example, "space f f" has the
+// prefixes "space" and "space f".
+func HasPrefix(k, prefix types.Key) bool {
+	if k == nil || prefix == nil {
+		return false
+	}
+	seq := k.Sequence()
+	pseq := prefix.Sequence()
+	if len(pseq) >= len(seq) {
+		return false
+	}
+	for x, pk := range pseq {
+		if !pk.Equal(seq[x]) {
+			return false
+		}
+	}
+	return true
+}
@@ -25,81 +25,24 @@ func init() {
 	)
 }
 
-// FromString returns a [types.Key] from a string.
+// FromString returns a [types.Key] from a string. A string containing
+// space-separated key press combinations, e.g. "g g", "ctrl+x ctrl+s" or
+// "space f f", returns a Key that is a sequence of those combinations.
+//
+// The string is not lowercased. A single printable character keeps its case,
+// so "Q" is Shift+Q and no longer matches a "q" key press. Use "q" to bind the
+// unshifted key.
+//
+// If the string is not a valid key press combination, an empty Key is
+// returned. Use [Parse] to get an error describing the problem instead.
 func FromString(subject string) *Key {
-	subject = strings.ToLower(subject)
-	// handle some special exceptions first...
-	if subject == "shift+tab" {
-		return KeyBacktab
-	}
-	parts := strings.Split(subject, "+")
-	numParts := len(parts)
-	switch {
-	case numParts > 1:
-		// modifiers were passed, e.g. "ctrl+alt+p"
-		finalKey := parts[numParts-1]
-		if lo.Contains(keyModifierStrings, finalKey) {
-			// finalKey cannot be a modifier... ignore and return NUL.
-			return &Key{}
-		}
-		npkc, ok := nonPrintableStringToKeyCode[finalKey]
-		if ok {
-			return &Key{code: npkc}
-		}
-		if len(finalKey) != 1 {
-			// we only support a single character as the final key if it's a
-			// printable key.
-			return &Key{}
-		}
-		mods := types.KeyModifiers(0)
-		for _, modKey := range parts[0 : numParts-1] {
-			mod, ok := stringToKeyModifier[modKey]
-			if !ok {
-				// modKey must be a modifier... ignore and return NUL.
-				return &Key{}
-			}
-			mods |= mod
-		}
-		k := &Key{
-			code: types.KeyCode([]rune(finalKey)[0]),
-		}
-		k.SetModifiers(mods)
-		return k
-	case numParts == 1:
-		finalKey := parts[0]
-		if lo.Contains(keyModifierStrings, finalKey) {
-			// finalKey cannot be a modifier... ignore and return NUL.
-			return &Key{}
-		}
-		npkc, ok := nonPrintableStringToKeyCode[finalKey]
-		if ok {
-			return &Key{code: npkc}
-		}
-		if len(finalKey) != 1 {
-			// we only support a single character as the final key if it's a
-			// printable key.
-			return &Key{}
-		}
-		return &Key{
-			code: types.KeyCode([]rune(finalKey)[0]),
-		}
-	default:
-		// empty string...
+	k, err := Parse(subject)
+	if err != nil {
 		return &Key{}
 	}
+	return k
 }
 
-var (
-	keyModifierStrings = []string{
-		"shift", "ctrl", "alt",
-	}
-	stringToKeyModifier = map[string]types.KeyModifiers{
-		"shift": types.KeyModifierShift,
-		"ctrl":  types.KeyModifierCtrl,
-		"alt":   types.KeyModifierAlt,
-	}
-)
-
 // tcellCodeFromString returns the [tcell.Key] representing a single keystroke.
 func tcellCodeFromString(subject string) tcell.Key {
 	named, ok := tcellKeyNameToCode[subject]
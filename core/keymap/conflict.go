@@ -0,0 +1,51 @@
+package keymap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jaypipes/gt/types"
+)
+
+// Conflict describes a problem with a Keymap's bindings.
+type Conflict struct {
+	// Scope is the KeymapScope of the problem binding.
+	Scope types.KeymapScope
+	// Key is the Key of the problem binding.
+	Key types.Key
+	// Actions are the names of the Actions involved.
+	Actions []string
+	// Reason describes the problem.
+	Reason string
+}
+
+// String returns a human-readable description of the Conflict.
+func (c Conflict) String() string {
+	return fmt.Sprintf(
+		"%s: %q (%s): %s",
+		c.Scope, c.Key.String(), strings.Join(c.Actions, ", "), c.Reason,
+	)
+}
+
+// Check returns the Conflicts found in the supplied Keymap.
+//
+// Check reports bindings to Actions not in the supplied ActionRegistry, if
+// one is supplied.
+func Check(m types.Keymap, registry types.ActionRegistry) []Conflict {
+	conflicts := []Conflict{}
+	bindings := m.Bindings()
+	for _, b := range bindings {
+		if b.Action == "" {
+			continue
+		}
+		if registry != nil && registry.Action(b.Action) == nil {
+			conflicts = append(conflicts, Conflict{
+				Scope:   b.Scope,
+				Key:     b.Key,
+				Actions: []string{b.Action},
+				Reason:  "unknown action",
+			})
+		}
+	}
+	return conflicts
+}
@@ -0,0 +1,84 @@
+package keymap
+
+import (
+	"sync"
+
+	"github.com/jaypipes/gt/types"
+)
+
+// Keymap maps Keys to the names of Actions, per KeymapScope.
+type Keymap struct {
+	sync.RWMutex
+	// bindings are the Keymap's KeyBindings in the order they were bound.
+	bindings []types.KeyBinding
+}
+
+// Bind binds the supplied Key to the named Action within the supplied
+// KeymapScope, replacing any Action the Key was bound to in that KeymapScope.
+//
+// Binding a Key to an empty Action name marks the Key as unbound: Lookup
+// reports it as not bound and Merge removes it from the base Keymap.
+func (m *Keymap) Bind(scope types.KeymapScope, k types.Key, action string) {
+	if k == nil {
+		return
+	}
+	m.Lock()
+	defer m.Unlock()
+	if i := m.index(scope, k); i >= 0 {
+		m.bindings[i].Action = action
+		return
+	}
+	m.bindings = append(m.bindings, types.KeyBinding{
+		Scope:  scope,
+		Key:    k,
+		Action: action,
+	})
+}
+
+// Unbind removes any binding of the supplied Key within the supplied
+// KeymapScope.
+func (m *Keymap) Unbind(scope types.KeymapScope, k types.Key) {
+	if k == nil {
+		return
+	}
+	m.Lock()
+	defer m.Unlock()
+	if i := m.index(scope, k); i >= 0 {
+		m.bindings = append(m.bindings[:i], m.bindings[i+1:]...)
+	}
+}
+
+// Lookup returns the name of the Action the supplied Key is bound to within
+// the supplied KeymapScope and whether the Key is bound.
+func (m *Keymap) Lookup(scope types.KeymapScope, k types.Key) (string, bool) {
+	if k == nil {
+		return "", false
+	}
+	m.RLock()
+	defer m.RUnlock()
+	if i := m.index(scope, k); i >= 0 && m.bindings[i].Action != "" {
+		return m.bindings[i].Action, true
+	}
+	return "", false
+}
+
+// Bindings returns all KeyBindings in the order they were bound, including
+// those marking a Key as unbound.
+func (m *Keymap) Bindings() []types.KeyBinding {
+	m.RLock()
+	defer m.RUnlock()
+	return append([]types.KeyBinding{}, m.bindings...)
+}
+
+// index returns the index of the binding of the supplied Key within the
+// supplied KeymapScope, or -1.
+func (m *Keymap) index(scope types.KeymapScope, k types.Key) int {
+	for i, b := range m.bindings {
+		if b.Scope == scope && b.Key.Equal(k) {
+			return i
+		}
+	}
+	return -1
+}
+
+var _ types.Keymap = (*Keymap)(nil)
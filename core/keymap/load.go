@@ -0,0 +1,168 @@
+package keymap
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+
+	"github.com/jaypipes/gt/core/key"
+	"github.com/jaypipes/gt/types"
+)
+
+// Format is the file format of a keymap file.
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+	FormatTOML Format = "toml"
+)
+
+// FormatFromPath returns the Format of the keymap file at the supplied path,
+// based on the file's extension.
+func FormatFromPath(path string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return FormatJSON, nil
+	case ".yaml", ".yml":
+		return FormatYAML, nil
+	case ".toml":
+		return FormatTOML, nil
+	}
+	return "", fmt.Errorf("unknown keymap file format for %q", path)
+}
+
+// Spec is the contents of a keymap file. Each scope maps key strings, e.g.
+// "ctrl+s" or "g g", to Action names. An empty Action name unbinds the key.
+type Spec struct {
+	// Application contains bindings that apply everywhere in the
+	// Application.
+	Application map[string]string `json:"application,omitempty" yaml:"application,omitempty" toml:"application,omitempty"`
+	// Views contains bindings that apply while a View is active, keyed by
+	// View ID.
+	Views map[string]map[string]string `json:"views,omitempty" yaml:"views,omitempty" toml:"views,omitempty"`
+	// Classes contains bindings that apply while an Element of a class, or
+	// one of its descendants, has the focus, keyed by Element class.
+	Classes map[string]map[string]string `json:"classes,omitempty" yaml:"classes,omitempty" toml:"classes,omitempty"`
+}
+
+// LoadFile returns a new Keymap built from the JSON, YAML or TOML keymap file
+// at the supplied path. The file's format is determined from its extension.
+func LoadFile(path string) (*Keymap, error) {
+	format, err := FormatFromPath(path)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	m, err := Load(f, format)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return m, nil
+}
+
+// Load returns a new Keymap built from the keymap file contents in the
+// supplied format read from the supplied reader.
+func Load(r io.Reader, format Format) (*Keymap, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	spec := &Spec{}
+	switch format {
+	case FormatJSON:
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(spec); err != nil && err != io.EOF {
+			return nil, err
+		}
+	case FormatYAML:
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(spec); err != nil && err != io.EOF {
+			return nil, err
+		}
+	case FormatTOML:
+		md, err := toml.NewDecoder(bytes.NewReader(data)).Decode(spec)
+		if err != nil {
+			return nil, err
+		}
+		if undecoded := md.Undecoded(); len(undecoded) > 0 {
+			return nil, fmt.Errorf("unknown field %q", undecoded[0].String())
+		}
+	default:
+		return nil, fmt.Errorf("unknown keymap file format %q", format)
+	}
+	return FromSpec(spec)
+}
+
+// FromSpec returns a new Keymap built from the supplied Spec.
+//
+// An error is returned if a key string cannot be parsed or if two key strings
+// in the same scope, e.g. "ctrl+s" and "C-s", name the same key but are bound
+// to different Actions.
+func FromSpec(spec *Spec) (*Keymap, error) {
+	m := New()
+	if err := m.bindSpec(Application(), spec.Application); err != nil {
+		return nil, err
+	}
+	for _, id := range sortedKeys(spec.Views) {
+		if err := m.bindSpec(View(id), spec.Views[id]); err != nil {
+			return nil, err
+		}
+	}
+	for _, class := range sortedKeys(spec.Classes) {
+		if err := m.bindSpec(Class(class), spec.Classes[class]); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// bindSpec binds the supplied key strings to Action names within the supplied
+// KeymapScope.
+func (m *Keymap) bindSpec(
+	scope types.KeymapScope, bindings map[string]string,
+) error {
+	seen := map[string]string{}
+	for _, s := range sortedKeys(bindings) {
+		action := bindings[s]
+		k, err := key.Parse(s)
+		if err != nil {
+			return fmt.Errorf("%s: %w", scope, err)
+		}
+		if prev, ok := seen[k.String()]; ok {
+			if other, _ := m.Lookup(scope, k); other != action {
+				return fmt.Errorf(
+					"%s: %q and %q both bind %q, to %q and %q",
+					scope, prev, s, k.String(), other, action,
+				)
+			}
+		}
+		seen[k.String()] = s
+		m.Bind(scope, k, action)
+	}
+	return nil
+}
+
+// sortedKeys returns the keys of the supplied map in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
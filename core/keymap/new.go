@@ -0,0 +1,65 @@
+package keymap
+
+import "github.com/jaypipes/gt/types"
+
+// New returns a new Keymap.
+//
+// You can pass zero or more KeymapWithOptions to bind Keys on the returned
+// Keymap.
+func New(opts ...types.KeymapWithOption) *Keymap {
+	m := &Keymap{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithBinding binds the supplied Key to the named Action within the supplied
+// KeymapScope.
+func WithBinding(
+	scope types.KeymapScope, k types.Key, action string,
+) types.KeymapWithOption {
+	return func(m types.Keymap) {
+		m.Bind(scope, k, action)
+	}
+}
+
+// Application returns the KeymapScope for bindings that apply everywhere in
+// the Application.
+func Application() types.KeymapScope {
+	return types.KeymapScope{Kind: types.KeymapScopeApplication}
+}
+
+// View returns the KeymapScope for bindings that apply while the View with
+// the supplied ID is active.
+func View(id string) types.KeymapScope {
+	return types.KeymapScope{Kind: types.KeymapScopeView, Name: id}
+}
+
+// Class returns the KeymapScope for bindings that apply while an Element of
+// the supplied class, or one of its descendants, has the focus.
+func Class(class string) types.KeymapScope {
+	return types.KeymapScope{Kind: types.KeymapScopeClass, Name: class}
+}
+
+// Merge returns a new Keymap containing the bindings of the supplied base
+// Keymap overridden, in order, by the bindings of the supplied overrides.
+//
+// An override binding a Key to an empty Action name removes the base
+// binding, so a user's keymap file can unbind a default.
+func Merge(base types.Keymap, overrides ...types.Keymap) *Keymap {
+	m := New()
+	for _, km := range append([]types.Keymap{base}, overrides...) {
+		if km == nil {
+			continue
+		}
+		for _, b := range km.Bindings() {
+			if b.Action == "" {
+				m.Unbind(b.Scope, b.Key)
+				continue
+			}
+			m.Bind(b.Scope, b.Key, b.Action)
+		}
+	}
+	return m
+}
@@ -35,4 +35,19 @@ func (m *KeyModifiable) Alt() bool {
 	return m.modifiers&types.KeyModifierAlt != 0
 }
 
+// Meta returns true if the Meta modifier key was held.
+func (m *KeyModifiable) Meta() bool {
+	return m.modifiers&types.KeyModifierMeta != 0
+}
+
+// Super returns true if the Super modifier key was held.
+func (m *KeyModifiable) Super() bool {
+	return m.modifiers&types.KeyModifierSuper != 0
+}
+
+// Hyper returns true if the Hyper modifier key was held.
+func (m *KeyModifiable) Hyper() bool {
+	return m.modifiers&types.KeyModifierHyper != 0
+}
+
 var _ types.KeyModifiable = (*KeyModifiable)(nil)
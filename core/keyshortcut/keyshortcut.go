@@ -8,6 +8,9 @@ type KeyShortcut struct {
 	// key is the specific keypress combination that will fire the shortcut
 	// action.
 	key types.Key
+	// description is a short, human-readable description of what the
+	// shortcut does.
+	description string
 	// callback isthe KeyShortcutCallback that will be executed when the
 	// shortcut's keypress combination is pressed by the user.
 	callback types.KeyShortcutCallback
@@ -25,6 +28,17 @@ func (k *KeyShortcut) SetKey(key types.Key) {
 	k.key = key
 }
 
+// Description returns a short, human-readable description of what the
+// shortcut does.
+func (k *KeyShortcut) Description() string {
+	return k.description
+}
+
+// SetDescription sets the description of what the shortcut does.
+func (k *KeyShortcut) SetDescription(description string) {
+	k.description = description
+}
+
 // Callback returns the KeyShortcutCallback that will be executed when the
 // shortcut's keypress combination is pressed by the user.
 func (k *KeyShortcut) Callback() types.KeyShortcutCallback {
@@ -36,3 +50,5 @@ func (k *KeyShortcut) Callback() types.KeyShortcutCallback {
 func (k *KeyShortcut) SetCallback(cb types.KeyShortcutCallback) {
 	k.callback = cb
 }
+
+var _ types.KeyShortcut = (*KeyShortcut)(nil)
@@ -28,6 +28,13 @@ func WithKey(key types.Key) types.KeyShortcutWithOption {
 	}
 }
 
+// WithDescription sets the description of what the KeyShortcut does.
+func WithDescription(description string) types.KeyShortcutWithOption {
+	return func(k types.KeyShortcut) {
+		k.SetDescription(description)
+	}
+}
+
 // WithCallback sets the KeyShortcutCallback in the KeyShortcut.
 func WithCallback(cb types.KeyShortcutCallback) types.KeyShortcutWithOption {
 	return func(k types.KeyShortcut) {
@@ -0,0 +1,43 @@
+package mode
+
+import "github.com/jaypipes/gt/types"
+
+// Mode is a named input mode of an Application having its own Keymap.
+type Mode struct {
+	// name is the Mode's unique name.
+	name string
+	// keymap contains the bindings that apply while the Mode is current.
+	keymap types.Keymap
+	// insert is true if the Mode is an insert-like Mode.
+	insert bool
+}
+
+// Name returns the Mode's unique name.
+func (m *Mode) Name() string {
+	return m.name
+}
+
+// Keymap returns the Keymap whose bindings apply while the Mode is the
+// current Mode, or nil.
+func (m *Mode) Keymap() types.Keymap {
+	return m.keymap
+}
+
+// SetKeymap sets the Keymap whose bindings apply while the Mode is the
+// current Mode.
+func (m *Mode) SetKeymap(km types.Keymap) {
+	m.keymap = km
+}
+
+// Insert returns true if the Mode is an insert-like Mode that the Application
+// switches to while a text input intercepts key presses.
+func (m *Mode) Insert() bool {
+	return m.insert
+}
+
+// SetInsert sets whether the Mode is an insert-like Mode.
+func (m *Mode) SetInsert(on bool) {
+	m.insert = on
+}
+
+var _ types.Mode = (*Mode)(nil)
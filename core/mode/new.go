@@ -0,0 +1,31 @@
+package mode
+
+import "github.com/jaypipes/gt/types"
+
+// New returns a new Mode with the supplied name.
+//
+// You can pass zero or more ModeWithOptions to optionally set certain
+// attributes on the returned Mode.
+func New(name string, opts ...types.ModeWithOption) *Mode {
+	m := &Mode{name: name}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithKeymap sets the Keymap whose bindings apply while the Mode is the
+// current Mode.
+func WithKeymap(km types.Keymap) types.ModeWithOption {
+	return func(m types.Mode) {
+		m.SetKeymap(km)
+	}
+}
+
+// WithInsert sets whether the Mode is an insert-like Mode that the
+// Application switches to while a text input intercepts key presses.
+func WithInsert(on bool) types.ModeWithOption {
+	return func(m types.Mode) {
+		m.SetInsert(on)
+	}
+}
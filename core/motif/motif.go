@@ -4,7 +4,7 @@ import "github.com/jaypipes/gt/types"
 
 // Motif is a design pattern for an Element that encapsulates different styles
 // and borders when the Element is in various states (focused, being hovered
-// over with the mouse, disabled, etc)
+// over with the mouse, disabled, pressed, selected, checked, invalid, etc)
 type Motif struct {
 	// normalStyle contains the styling for when the thing is not being hovered
 	// over with a mouse, does not have the focus and is not disabled.
@@ -26,6 +26,26 @@ type Motif struct {
 	// hoveredBorder contains the border for when the mouse is hovering over
 	// the thing.
 	hoveredBorder types.Border
+	// pressedStyle contains the styling for when a mouse button is held down on
+	// the thing.
+	pressedStyle types.Style
+	// pressedBorder contains the border for when a mouse button is held down on
+	// the thing.
+	pressedBorder types.Border
+	// selectedStyle contains the styling for when the thing is selected.
+	selectedStyle types.Style
+	// selectedBorder contains the border for when the thing is selected.
+	selectedBorder types.Border
+	// checkedStyle contains the styling for when the thing is checked.
+	checkedStyle types.Style
+	// checkedBorder contains the border for when the thing is checked.
+	checkedBorder types.Border
+	// invalidStyle contains the styling for when the thing has an invalid
+	// value.
+	invalidStyle types.Style
+	// invalidBorder contains the border for when the thing has an invalid
+	// value.
+	invalidBorder types.Border
 }
 
 // Unstyled returns true if the Motif has no styling set up.
@@ -33,7 +53,11 @@ func (m *Motif) Unstyled() bool {
 	return (m.normalStyle == nil || m.normalStyle.Unstyled()) &&
 		(m.disabledStyle == nil || m.disabledStyle.Unstyled()) &&
 		(m.focusedStyle == nil || m.focusedStyle.Unstyled()) &&
-		(m.hoveredStyle == nil || m.hoveredStyle.Unstyled())
+		(m.hoveredStyle == nil || m.hoveredStyle.Unstyled()) &&
+		(m.pressedStyle == nil || m.pressedStyle.Unstyled()) &&
+		(m.selectedStyle == nil || m.selectedStyle.Unstyled()) &&
+		(m.checkedStyle == nil || m.checkedStyle.Unstyled()) &&
+		(m.invalidStyle == nil || m.invalidStyle.Unstyled())
 }
 
 // NormalStyle returns the styling for when the thing is not being hovered
@@ -182,4 +206,144 @@ func (m *Motif) WithHoveredBorder(b types.Border) types.Motif {
 	return m
 }
 
+// PressedStyle returns the styling for when a mouse button is held down on the
+// thing.
+func (m *Motif) PressedStyle() types.Style {
+	return m.pressedStyle
+}
+
+// SetPressedStyle sets the styling for when a mouse button is held down on the
+// thing.
+func (m *Motif) SetPressedStyle(v types.Style) {
+	m.pressedStyle = v
+}
+
+// WithPressedStyle sets the styling for when a mouse button is held down on the
+// thing and returns the Motif.
+func (m *Motif) WithPressedStyle(v types.Style) types.Motif {
+	m.SetPressedStyle(v)
+	return m
+}
+
+// PressedBorder returns the border for when a mouse button is held down on the
+// thing.
+func (m *Motif) PressedBorder() types.Border {
+	return m.pressedBorder
+}
+
+// SetPressedBorder sets the border for when a mouse button is held down on the
+// thing.
+func (m *Motif) SetPressedBorder(v types.Border) {
+	m.pressedBorder = v
+}
+
+// WithPressedBorder sets the border for when a mouse button is held down on the
+// thing and returns the Motif.
+func (m *Motif) WithPressedBorder(v types.Border) types.Motif {
+	m.SetPressedBorder(v)
+	return m
+}
+
+// SelectedStyle returns the styling for when the thing is selected.
+func (m *Motif) SelectedStyle() types.Style {
+	return m.selectedStyle
+}
+
+// SetSelectedStyle sets the styling for when the thing is selected.
+func (m *Motif) SetSelectedStyle(v types.Style) {
+	m.selectedStyle = v
+}
+
+// WithSelectedStyle sets the styling for when the thing is selected and
+// returns the Motif.
+func (m *Motif) WithSelectedStyle(v types.Style) types.Motif {
+	m.SetSelectedStyle(v)
+	return m
+}
+
+// SelectedBorder returns the border for when the thing is selected.
+func (m *Motif) SelectedBorder() types.Border {
+	return m.selectedBorder
+}
+
+// SetSelectedBorder sets the border for when the thing is selected.
+func (m *Motif) SetSelectedBorder(v types.Border) {
+	m.selectedBorder = v
+}
+
+// WithSelectedBorder sets the border for when the thing is selected and
+// returns the Motif.
+func (m *Motif) WithSelectedBorder(v types.Border) types.Motif {
+	m.SetSelectedBorder(v)
+	return m
+}
+
+// CheckedStyle returns the styling for when the thing is checked.
+func (m *Motif) CheckedStyle() types.Style {
+	return m.checkedStyle
+}
+
+// SetCheckedStyle sets the styling for when the thing is checked.
+func (m *Motif) SetCheckedStyle(v types.Style) {
+	m.checkedStyle = v
+}
+
+// WithCheckedStyle sets the styling for when the thing is checked and
+// returns the Motif.
+func (m *Motif) WithCheckedStyle(v types.Style) types.Motif {
+	m.SetCheckedStyle(v)
+	return m
+}
+
+// CheckedBorder returns the border for when the thing is checked.
+func (m *Motif) CheckedBorder() types.Border {
+	return m.checkedBorder
+}
+
+// SetCheckedBorder sets the border for when the thing is checked.
+func (m *Motif) SetCheckedBorder(v types.Border) {
+	m.checkedBorder = v
+}
+
+// WithCheckedBorder sets the border for when the thing is checked and
+// returns the Motif.
+func (m *Motif) WithCheckedBorder(v types.Border) types.Motif {
+	m.SetCheckedBorder(v)
+	return m
+}
+
+// InvalidStyle returns the styling for when the thing has an invalid value.
+func (m *Motif) InvalidStyle() types.Style {
+	return m.invalidStyle
+}
+
+// SetInvalidStyle sets the styling for when the thing has an invalid value.
+func (m *Motif) SetInvalidStyle(v types.Style) {
+	m.invalidStyle = v
+}
+
+// WithInvalidStyle sets the styling for when the thing has an invalid value and
+// returns the Motif.
+func (m *Motif) WithInvalidStyle(v types.Style) types.Motif {
+	m.SetInvalidStyle(v)
+	return m
+}
+
+// InvalidBorder returns the border for when the thing has an invalid value.
+func (m *Motif) InvalidBorder() types.Border {
+	return m.invalidBorder
+}
+
+// SetInvalidBorder sets the border for when the thing has an invalid value.
+func (m *Motif) SetInvalidBorder(v types.Border) {
+	m.invalidBorder = v
+}
+
+// WithInvalidBorder sets the border for when the thing has an invalid value and
+// returns the Motif.
+func (m *Motif) WithInvalidBorder(v types.Border) types.Motif {
+	m.SetInvalidBorder(v)
+	return m
+}
+
 var _ types.Motif = (*Motif)(nil)
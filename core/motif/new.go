@@ -86,3 +86,67 @@ func WithHoveredBorder(b types.Border) types.MotifWithOption {
 		m.SetHoveredBorder(b)
 	}
 }
+
+// WithPressedStyle sets the styling for when a mouse button is held down on the
+// thing and returns the Motif.
+func WithPressedStyle(v types.Style) types.MotifWithOption {
+	return func(m types.Motif) {
+		m.SetPressedStyle(v)
+	}
+}
+
+// WithPressedBorder sets the border for when a mouse button is held down on the
+// thing and returns the Motif.
+func WithPressedBorder(v types.Border) types.MotifWithOption {
+	return func(m types.Motif) {
+		m.SetPressedBorder(v)
+	}
+}
+
+// WithSelectedStyle sets the styling for when the thing is selected and
+// returns the Motif.
+func WithSelectedStyle(v types.Style) types.MotifWithOption {
+	return func(m types.Motif) {
+		m.SetSelectedStyle(v)
+	}
+}
+
+// WithSelectedBorder sets the border for when the thing is selected and
+// returns the Motif.
+func WithSelectedBorder(v types.Border) types.MotifWithOption {
+	return func(m types.Motif) {
+		m.SetSelectedBorder(v)
+	}
+}
+
+// WithCheckedStyle sets the styling for when the thing is checked and
+// returns the Motif.
+func WithCheckedStyle(v types.Style) types.MotifWithOption {
+	return func(m types.Motif) {
+		m.SetCheckedStyle(v)
+	}
+}
+
+// WithCheckedBorder sets the border for when the thing is checked and
+// returns the Motif.
+func WithCheckedBorder(v types.Border) types.MotifWithOption {
+	return func(m types.Motif) {
+		m.SetCheckedBorder(v)
+	}
+}
+
+// WithInvalidStyle sets the styling for when the thing has an invalid value and
+// returns the Motif.
+func WithInvalidStyle(v types.Style) types.MotifWithOption {
+	return func(m types.Motif) {
+		m.SetInvalidStyle(v)
+	}
+}
+
+// WithInvalidBorder sets the border for when the thing has an invalid value and
+// returns the Motif.
+func WithInvalidBorder(v types.Border) types.MotifWithOption {
+	return func(m types.Motif) {
+		m.SetInvalidBorder(v)
+	}
+}
@@ -52,6 +52,20 @@ var (
 					WithBackgroundColor(color.Transparent).
 					WithForegroundColor(NordDarkPrimaryFocusedBackgroundColor)
 
+	NordDarkPrimaryPressedBackgroundColor = palette.Nord3
+	NordDarkPrimaryPressedForegroundColor = palette.Nord6
+	NordDarkPrimaryPressedStyle           = style.New(
+		style.WithForegroundColor(
+			NordDarkPrimaryPressedForegroundColor,
+		),
+		style.WithBackgroundColor(
+			NordDarkPrimaryPressedBackgroundColor,
+		),
+	)
+	NordDarkPrimaryPressedBorder = border.InnerHalfBlock().
+					WithBackgroundColor(color.Transparent).
+					WithForegroundColor(NordDarkPrimaryPressedBackgroundColor)
+
 	NordDarkPrimary = New(
 		WithNormalStyle(NordDarkPrimaryNormalStyle),
 		WithNormalBorder(NordDarkPrimaryNormalBorder),
@@ -59,6 +73,8 @@ var (
 		WithFocusedBorder(NordDarkPrimaryFocusedBorder),
 		WithHoveredStyle(NordDarkPrimaryHoveredStyle),
 		WithHoveredBorder(NordDarkPrimaryHoveredBorder),
+		WithPressedStyle(NordDarkPrimaryPressedStyle),
+		WithPressedBorder(NordDarkPrimaryPressedBorder),
 	)
 
 	NordDarkContrastNormalBackgroundColor = palette.NordDarkContrastBackground
@@ -103,6 +119,20 @@ var (
 					WithBackgroundColor(color.Transparent).
 					WithForegroundColor(NordDarkContrastHoveredBackgroundColor)
 
+	NordDarkContrastPressedBackgroundColor = palette.Nord3
+	NordDarkContrastPressedForegroundColor = palette.Nord6
+	NordDarkContrastPressedStyle           = style.New(
+		style.WithForegroundColor(
+			NordDarkContrastPressedForegroundColor,
+		),
+		style.WithBackgroundColor(
+			NordDarkContrastPressedBackgroundColor,
+		),
+	)
+	NordDarkContrastPressedBorder = border.InnerHalfBlock().
+					WithBackgroundColor(color.Transparent).
+					WithForegroundColor(NordDarkContrastPressedBackgroundColor)
+
 	NordDarkContrast = New(
 		WithNormalStyle(NordDarkContrastNormalStyle),
 		WithNormalBorder(NordDarkContrastNormalBorder),
@@ -110,6 +140,8 @@ var (
 		WithFocusedBorder(NordDarkContrastFocusedBorder),
 		WithHoveredStyle(NordDarkContrastHoveredStyle),
 		WithHoveredBorder(NordDarkContrastHoveredBorder),
+		WithPressedStyle(NordDarkContrastPressedStyle),
+		WithPressedBorder(NordDarkContrastPressedBorder),
 	)
 
 	// Motifs using Nord palette with light terminal screen backgrounds.
@@ -141,11 +173,27 @@ var (
 					WithBackgroundColor(color.Transparent).
 					WithForegroundColor(NordLightPrimaryHoveredBackgroundColor)
 
+	NordLightPrimaryPressedBackgroundColor = palette.Nord4
+	NordLightPrimaryPressedForegroundColor = palette.Nord0
+	NordLightPrimaryPressedStyle           = style.New(
+		style.WithForegroundColor(
+			NordLightPrimaryPressedForegroundColor,
+		),
+		style.WithBackgroundColor(
+			NordLightPrimaryPressedBackgroundColor,
+		),
+	)
+	NordLightPrimaryPressedBorder = border.InnerHalfBlock().
+					WithBackgroundColor(color.Transparent).
+					WithForegroundColor(NordLightPrimaryPressedBackgroundColor)
+
 	NordLightPrimary = New(
 		WithNormalStyle(NordLightPrimaryNormalStyle),
 		WithNormalBorder(NordLightPrimaryNormalBorder),
 		WithHoveredStyle(NordLightPrimaryHoveredStyle),
 		WithHoveredBorder(NordLightPrimaryHoveredBorder),
+		WithPressedStyle(NordLightPrimaryPressedStyle),
+		WithPressedBorder(NordLightPrimaryPressedBorder),
 	)
 
 	NordLightContrastNormalBackgroundColor = palette.NordLightContrastBackground
@@ -176,10 +224,26 @@ var (
 					WithBackgroundColor(color.Transparent).
 					WithForegroundColor(NordLightContrastHoveredBackgroundColor)
 
+	NordLightContrastPressedBackgroundColor = palette.Nord5
+	NordLightContrastPressedForegroundColor = palette.Nord0
+	NordLightContrastPressedStyle           = style.New(
+		style.WithForegroundColor(
+			NordLightContrastPressedForegroundColor,
+		),
+		style.WithBackgroundColor(
+			NordLightContrastPressedBackgroundColor,
+		),
+	)
+	NordLightContrastPressedBorder = border.InnerHalfBlock().
+					WithBackgroundColor(color.Transparent).
+					WithForegroundColor(NordLightContrastPressedBackgroundColor)
+
 	NordLightContrast = New(
 		WithNormalStyle(NordLightContrastNormalStyle),
 		WithNormalBorder(NordLightContrastNormalBorder),
 		WithHoveredStyle(NordLightContrastHoveredStyle),
 		WithHoveredBorder(NordLightContrastHoveredBorder),
+		WithPressedStyle(NordLightContrastPressedStyle),
+		WithPressedBorder(NordLightContrastPressedBorder),
 	)
 )
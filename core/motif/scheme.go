@@ -0,0 +1,135 @@
+package motif
+
+import (
+	"image/color"
+
+	"github.com/jaypipes/gt/core/border"
+	"github.com/jaypipes/gt/core/palette"
+	"github.com/jaypipes/gt/core/style"
+	"github.com/jaypipes/gt/types"
+)
+
+// FromScheme returns a new Motif for things having the supplied ThemeClass,
+// with colors taken from the roles of the supplied palette Scheme.
+//
+// ThemeClassPrimary and ThemeClassSecondary things are filled with the
+// Scheme's Primary and Secondary colors respectively, ThemeClassInput things
+// are drawn on the Scheme's Surface with a thin border and
+// ThemeClassNavigation things are drawn on the Scheme's Surface without a
+// border. The Accent color highlights the thing having the focus and selected
+// or checked things, and the Error color highlights invalid things. Except
+// for the disabled state, foreground colors are adjusted where needed to
+// reach the contrast ratio required by WCAG 2 level AA. Returns nil for any
+// other ThemeClass.
+func FromScheme(s *palette.Scheme, class types.ThemeClass) *Motif {
+	switch class {
+	case types.ThemeClassPrimary:
+		return filled(s, s.Primary, s.OnPrimary)
+	case types.ThemeClassSecondary:
+		return filled(s, s.Secondary, s.OnSecondary)
+	case types.ThemeClassInput:
+		return input(s)
+	case types.ThemeClassNavigation:
+		return navigation(s)
+	}
+	return nil
+}
+
+// filled returns a Motif for things filled with the supplied background color
+// and surrounded by a half-block border of the same color. When pressed, the
+// colors are inverted and the border takes the terminal background color so
+// that the thing looks pushed in.
+func filled(s *palette.Scheme, bg, fg types.Color) *Motif {
+	selected := colors(fg, bg)
+	selected.SetBold(true)
+	return New(
+		WithNormalStyle(colors(fg, bg)),
+		WithNormalBorder(halfBlock(bg)),
+		WithHoveredStyle(colors(bg, fg)),
+		WithHoveredBorder(halfBlock(fg)),
+		WithFocusedStyle(colors(s.OnAccent, s.Accent)),
+		WithFocusedBorder(halfBlock(s.Accent)),
+		WithDisabledStyle(disabled(s)),
+		WithDisabledBorder(halfBlock(s.Surface)),
+		WithPressedStyle(colors(bg, s.Background)),
+		WithPressedBorder(halfBlock(s.Background)),
+		WithSelectedStyle(selected),
+		WithSelectedBorder(halfBlock(s.Accent)),
+		WithCheckedStyle(selected),
+		WithCheckedBorder(halfBlock(s.Accent)),
+		WithInvalidStyle(colors(s.OnPrimary, s.Error)),
+		WithInvalidBorder(halfBlock(s.Error)),
+	)
+}
+
+// input returns a Motif for input and form things.
+func input(s *palette.Scheme) *Motif {
+	return New(
+		WithNormalStyle(colors(s.Foreground, s.Surface)),
+		WithNormalBorder(thin(s.Muted)),
+		WithHoveredStyle(colors(s.Foreground, s.Surface)),
+		WithHoveredBorder(thin(s.Foreground)),
+		WithFocusedStyle(colors(s.Foreground, s.Surface)),
+		WithFocusedBorder(thin(s.Accent)),
+		WithDisabledStyle(disabled(s)),
+		WithDisabledBorder(thin(s.Surface)),
+		WithPressedStyle(colors(s.Foreground, s.Surface)),
+		WithPressedBorder(thin(s.Accent)),
+		WithSelectedStyle(colors(s.OnAccent, s.Accent)),
+		WithCheckedStyle(colors(s.OnAccent, s.Accent)),
+		WithInvalidStyle(colors(s.Foreground, s.Surface)),
+		WithInvalidBorder(thin(s.Error)),
+	)
+}
+
+// navigation returns a Motif for navigation things like tab bars.
+func navigation(s *palette.Scheme) *Motif {
+	focused := colors(s.OnAccent, s.Accent)
+	focused.SetBold(true)
+	selected := colors(s.Accent, s.Background)
+	selected.SetBold(true)
+	return New(
+		WithNormalStyle(colors(s.Foreground, s.Surface)),
+		WithHoveredStyle(colors(s.Accent, s.Surface)),
+		WithFocusedStyle(focused),
+		WithDisabledStyle(disabled(s)),
+		WithPressedStyle(colors(s.OnAccent, s.Accent)),
+		WithSelectedStyle(selected),
+		WithCheckedStyle(selected),
+		WithInvalidStyle(colors(s.Error, s.Surface)),
+	)
+}
+
+// colors returns a Style with the supplied background color and the supplied
+// foreground color, adjusted if needed to have at least the contrast ratio
+// required by WCAG 2 level AA against the background.
+func colors(fg, bg types.Color) types.Style {
+	return style.New(
+		style.WithForegroundColor(
+			palette.AdjustContrast(fg, bg, palette.ContrastAA),
+		),
+		style.WithBackgroundColor(bg),
+	)
+}
+
+// disabled returns a Style drawing the Scheme's Muted color on its Surface
+// color without adjusting the contrast, since disabled things are inactive
+// and exempt from the WCAG contrast requirements.
+func disabled(s *palette.Scheme) types.Style {
+	return style.New(
+		style.WithForegroundColor(s.Muted),
+		style.WithBackgroundColor(s.Surface),
+	)
+}
+
+func halfBlock(fg types.Color) types.Border {
+	return border.InnerHalfBlock().
+		WithBackgroundColor(color.Transparent).
+		WithForegroundColor(fg)
+}
+
+func thin(fg types.Color) types.Border {
+	return border.Normal().
+		WithBackgroundColor(color.Transparent).
+		WithForegroundColor(fg)
+}
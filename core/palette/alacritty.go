@@ -0,0 +1,81 @@
+package palette
+
+import (
+	"fmt"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/jaypipes/gt/types"
+)
+
+// alacrittyANSI is a set of 8 colors in an Alacritty config file.
+type alacrittyANSI struct {
+	Black   string `toml:"black"`
+	Red     string `toml:"red"`
+	Green   string `toml:"green"`
+	Yellow  string `toml:"yellow"`
+	Blue    string `toml:"blue"`
+	Magenta string `toml:"magenta"`
+	Cyan    string `toml:"cyan"`
+	White   string `toml:"white"`
+}
+
+func (a alacrittyANSI) colors() []string {
+	return []string{
+		a.Black, a.Red, a.Green, a.Yellow,
+		a.Blue, a.Magenta, a.Cyan, a.White,
+	}
+}
+
+// loadAlacritty returns a new Extended Palette from the colors section of an
+// Alacritty TOML config file.
+func loadAlacritty(data []byte) (*Extended, error) {
+	var doc struct {
+		Colors struct {
+			Primary struct {
+				Foreground string `toml:"foreground"`
+				Background string `toml:"background"`
+			} `toml:"primary"`
+			Normal alacrittyANSI `toml:"normal"`
+			Bright alacrittyANSI `toml:"bright"`
+		} `toml:"colors"`
+	}
+	if _, err := toml.Decode(string(data), &doc); err != nil {
+		return nil, err
+	}
+	names := [8]string{
+		"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white",
+	}
+	terminal := [16]types.Color{}
+	all := append(doc.Colors.Normal.colors(), doc.Colors.Bright.colors()...)
+	for i, s := range all {
+		section := "normal"
+		if i >= 8 {
+			section = "bright"
+		}
+		if s == "" {
+			return nil, fmt.Errorf("missing colors.%s.%s", section, names[i%8])
+		}
+		c, err := parseHex(s)
+		if err != nil {
+			return nil, fmt.Errorf("colors.%s.%s: %w", section, names[i%8], err)
+		}
+		terminal[i] = c
+	}
+	fg, bg := terminal[7], terminal[0]
+	if s := doc.Colors.Primary.Foreground; s != "" {
+		c, err := parseHex(s)
+		if err != nil {
+			return nil, fmt.Errorf("colors.primary.foreground: %w", err)
+		}
+		fg = c
+	}
+	if s := doc.Colors.Primary.Background; s != "" {
+		c, err := parseHex(s)
+		if err != nil {
+			return nil, fmt.Errorf("colors.primary.background: %w", err)
+		}
+		bg = c
+	}
+	return fromTerminal(terminal, fg, bg), nil
+}
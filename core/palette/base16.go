@@ -0,0 +1,87 @@
+package palette
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/jaypipes/gt/types"
+)
+
+// base16 scheme: https://github.com/tinted-theming/home/blob/main/styling.md
+
+// loadBase16 returns a new Extended Palette from a base16 or base24 YAML
+// scheme. The scheme is treated as base24 if it defines base10 to base17.
+func loadBase16(data []byte) (*Extended, error) {
+	var doc map[string]any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if p, ok := doc["palette"].(map[string]any); ok {
+		doc = p
+	}
+	base := map[string]types.Color{}
+	for k, v := range doc {
+		k = strings.ToLower(k)
+		if !strings.HasPrefix(k, "base") || len(k) != 6 {
+			continue
+		}
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s: expected a hex color", k)
+		}
+		c, err := parseHex(s)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+		base[k] = c
+	}
+	for i := range 16 {
+		k := fmt.Sprintf("base%02x", i)
+		if _, ok := base[k]; !ok {
+			return nil, fmt.Errorf("missing %s", k)
+		}
+	}
+	b := func(i int) types.Color {
+		return base[fmt.Sprintf("base%02x", i)]
+	}
+	// bright returns the base24 bright color if defined, otherwise the
+	// supplied base16 color.
+	bright := func(i, fallback int) types.Color {
+		if c, ok := base[fmt.Sprintf("base%02x", i)]; ok {
+			return c
+		}
+		return b(fallback)
+	}
+
+	colors := types.PaletteColors{}
+	// base04 is a dark foreground used for status bars, which leaves seven
+	// grayscale colors.
+	copy(colors[:7], byLightness(
+		b(0x00), b(0x01), b(0x02), b(0x03), b(0x05), b(0x06), b(0x07),
+	))
+	colors[7] = b(0x0c)
+	colors[8] = bright(0x15, 0x0c)
+	colors[9] = bright(0x16, 0x0d)
+	colors[10] = b(0x0d)
+	colors[11] = b(0x08)
+	colors[12] = b(0x09)
+	colors[13] = b(0x0a)
+	colors[14] = b(0x0b)
+	colors[15] = b(0x0e)
+
+	// The terminal colors are those used by base16-shell.
+	terminal := [16]types.Color{
+		b(0x00), b(0x08), b(0x0b), b(0x0a),
+		b(0x0d), b(0x0e), b(0x0c), b(0x05),
+		b(0x03), bright(0x12, 0x08), bright(0x14, 0x0b), bright(0x13, 0x0a),
+		bright(0x16, 0x0d), bright(0x17, 0x0e), bright(0x15, 0x0c), b(0x07),
+	}
+	return NewExtended(
+		WithColors(colors),
+		WithANSIColors(ANSIColors(terminal)),
+		WithForeground(b(0x05)),
+		WithBackground(b(0x00)),
+	), nil
+}
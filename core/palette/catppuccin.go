@@ -0,0 +1,120 @@
+package palette
+
+import (
+	"github.com/lucasb-eyer/go-colorful"
+
+	"github.com/jaypipes/gt/types"
+)
+
+// Catppuccin Mocha (dark) and Latte (light) color palette: https://catppuccin.com/palette
+
+var (
+	CatppuccinMochaCrust, _    = colorful.Hex("#11111b")
+	CatppuccinMochaMantle, _   = colorful.Hex("#181825")
+	CatppuccinMochaBase, _     = colorful.Hex("#1e1e2e")
+	CatppuccinMochaSurface0, _ = colorful.Hex("#313244")
+	CatppuccinMochaSurface1, _ = colorful.Hex("#45475a")
+	CatppuccinMochaOverlay0, _ = colorful.Hex("#6c7086")
+	CatppuccinMochaSubtext0, _ = colorful.Hex("#a6adc8")
+	CatppuccinMochaText, _     = colorful.Hex("#cdd6f4")
+	CatppuccinMochaTeal, _     = colorful.Hex("#94e2d5")
+	CatppuccinMochaSky, _      = colorful.Hex("#89dceb")
+	CatppuccinMochaBlue, _     = colorful.Hex("#89b4fa")
+	CatppuccinMochaLavender, _ = colorful.Hex("#b4befe")
+	CatppuccinMochaRed, _      = colorful.Hex("#f38ba8")
+	CatppuccinMochaPeach, _    = colorful.Hex("#fab387")
+	CatppuccinMochaYellow, _   = colorful.Hex("#f9e2af")
+	CatppuccinMochaGreen, _    = colorful.Hex("#a6e3a1")
+	CatppuccinMochaMauve, _    = colorful.Hex("#cba6f7")
+	CatppuccinLatteText, _     = colorful.Hex("#4c4f69")
+	CatppuccinLatteSubtext0, _ = colorful.Hex("#6c6f85")
+	CatppuccinLatteOverlay0, _ = colorful.Hex("#9ca0b0")
+	CatppuccinLatteSurface1, _ = colorful.Hex("#bcc0cc")
+	CatppuccinLatteSurface0, _ = colorful.Hex("#ccd0da")
+	CatppuccinLatteMantle, _   = colorful.Hex("#e6e9ef")
+	CatppuccinLatteBase, _     = colorful.Hex("#eff1f5")
+	CatppuccinLatteTeal, _     = colorful.Hex("#179299")
+	CatppuccinLatteSky, _      = colorful.Hex("#04a5e5")
+	CatppuccinLatteBlue, _     = colorful.Hex("#1e66f5")
+	CatppuccinLatteLavender, _ = colorful.Hex("#7287fd")
+	CatppuccinLatteRed, _      = colorful.Hex("#d20f39")
+	CatppuccinLattePeach, _    = colorful.Hex("#fe640b")
+	CatppuccinLatteYellow, _   = colorful.Hex("#df8e1d")
+	CatppuccinLatteGreen, _    = colorful.Hex("#40a02b")
+	CatppuccinLatteMauve, _    = colorful.Hex("#8839ef")
+
+	CatppuccinDarkColors = types.PaletteColors{
+		CatppuccinMochaCrust,
+		CatppuccinMochaBase,
+		CatppuccinMochaSurface0,
+		CatppuccinMochaSurface1,
+		CatppuccinMochaOverlay0,
+		CatppuccinMochaSubtext0,
+		CatppuccinMochaText,
+		CatppuccinMochaTeal,
+		CatppuccinMochaSky,
+		CatppuccinMochaBlue,
+		CatppuccinMochaLavender,
+		CatppuccinMochaRed,
+		CatppuccinMochaPeach,
+		CatppuccinMochaYellow,
+		CatppuccinMochaGreen,
+		CatppuccinMochaMauve,
+	}
+
+	CatppuccinLightColors = types.PaletteColors{
+		CatppuccinLatteText,
+		CatppuccinLatteSubtext0,
+		CatppuccinLatteOverlay0,
+		CatppuccinLatteSurface1,
+		CatppuccinLatteSurface0,
+		CatppuccinLatteMantle,
+		CatppuccinLatteBase,
+		CatppuccinLatteTeal,
+		CatppuccinLatteSky,
+		CatppuccinLatteBlue,
+		CatppuccinLatteLavender,
+		CatppuccinLatteRed,
+		CatppuccinLattePeach,
+		CatppuccinLatteYellow,
+		CatppuccinLatteGreen,
+		CatppuccinLatteMauve,
+	}
+)
+
+var (
+	CatppuccinDark  = New(WithColors(CatppuccinDarkColors))
+	CatppuccinLight = New(WithColors(CatppuccinLightColors))
+
+	CatppuccinDarkScheme = &Scheme{
+		Palette:     CatppuccinDark,
+		Dark:        true,
+		Background:  CatppuccinMochaBase,
+		Surface:     CatppuccinMochaSurface0,
+		Muted:       CatppuccinMochaOverlay0,
+		Foreground:  CatppuccinMochaText,
+		Primary:     CatppuccinMochaBlue,
+		OnPrimary:   CatppuccinMochaBase,
+		Secondary:   CatppuccinMochaMauve,
+		OnSecondary: CatppuccinMochaBase,
+		Accent:      CatppuccinMochaPeach,
+		OnAccent:    CatppuccinMochaBase,
+		Error:       CatppuccinMochaRed,
+	}
+
+	CatppuccinLightScheme = &Scheme{
+		Palette:     CatppuccinLight,
+		Dark:        false,
+		Background:  CatppuccinLatteBase,
+		Surface:     CatppuccinLatteMantle,
+		Muted:       CatppuccinLatteOverlay0,
+		Foreground:  CatppuccinLatteText,
+		Primary:     CatppuccinLatteBlue,
+		OnPrimary:   CatppuccinLatteBase,
+		Secondary:   CatppuccinLatteMauve,
+		OnSecondary: CatppuccinLatteBase,
+		Accent:      CatppuccinLattePeach,
+		OnAccent:    CatppuccinLatteBase,
+		Error:       CatppuccinLatteRed,
+	}
+)
@@ -0,0 +1,98 @@
+package palette
+
+import (
+	"math"
+
+	"github.com/lucasb-eyer/go-colorful"
+
+	"github.com/jaypipes/gt/types"
+)
+
+const (
+	// ContrastAA is the minimum contrast ratio between text and its
+	// background required by WCAG 2 level AA.
+	ContrastAA = 4.5
+	// ContrastAAA is the minimum contrast ratio between text and its
+	// background required by WCAG 2 level AAA.
+	ContrastAAA = 7.0
+)
+
+// RelativeLuminance returns the WCAG 2 relative luminance of the supplied
+// color, from 0 for black to 1 for white.
+func RelativeLuminance(c types.Color) float64 {
+	r, g, b, _ := c.RGBA()
+	return 0.2126*linear(r) + 0.7152*linear(g) + 0.0722*linear(b)
+}
+
+// linear returns the linear value of the supplied 16-bit sRGB channel.
+func linear(v uint32) float64 {
+	c := float64(v) / 0xffff
+	if c <= 0.04045 {
+		return c / 12.92
+	}
+	return math.Pow((c+0.055)/1.055, 2.4)
+}
+
+// ContrastRatio returns the WCAG 2 contrast ratio between the supplied
+// foreground and background colors, from 1 for identical colors to 21 for
+// black on white.
+func ContrastRatio(fg, bg types.Color) float64 {
+	lf := RelativeLuminance(fg)
+	lb := RelativeLuminance(bg)
+	if lf < lb {
+		lf, lb = lb, lf
+	}
+	return (lf + 0.05) / (lb + 0.05)
+}
+
+// MeetsAA returns true if the supplied foreground and background colors have
+// at least the contrast ratio required by WCAG 2 level AA.
+func MeetsAA(fg, bg types.Color) bool {
+	return ContrastRatio(fg, bg) >= ContrastAA
+}
+
+// MeetsAAA returns true if the supplied foreground and background colors have
+// at least the contrast ratio required by WCAG 2 level AAA.
+func MeetsAAA(fg, bg types.Color) bool {
+	return ContrastRatio(fg, bg) >= ContrastAAA
+}
+
+// AdjustContrast returns the supplied foreground color with its lightness
+// nudged away from the supplied background color until the pair has at least
+// the supplied contrast ratio. The hue and chroma of the foreground color are
+// kept where possible, falling back to black or white. If the ratio cannot
+// be reached, the color with the highest contrast found is returned.
+func AdjustContrast(fg, bg types.Color, ratio float64) types.Color {
+	if ContrastRatio(fg, bg) >= ratio {
+		return fg
+	}
+	cf, _ := colorful.MakeColor(fg)
+	h, c, l := cf.Hcl()
+	// Lighten the foreground on dark backgrounds and darken it on light
+	// ones, then try the other way if that runs out of room.
+	steps := []float64{0.01, -0.01}
+	if RelativeLuminance(bg) > RelativeLuminance(fg) {
+		steps[0], steps[1] = steps[1], steps[0]
+	}
+	best := fg
+	bestRatio := ContrastRatio(fg, bg)
+	for _, step := range steps {
+		for ll := l + step; ll >= 0 && ll <= 1; ll += step {
+			cand := colorful.Hcl(h, c, ll).Clamped()
+			r := ContrastRatio(cand, bg)
+			if r > bestRatio {
+				best, bestRatio = cand, r
+			}
+			if r >= ratio {
+				return cand
+			}
+		}
+	}
+	black, white := colorful.Color{}, colorful.Color{R: 1, G: 1, B: 1}
+	for _, cand := range []types.Color{black, white} {
+		if r := ContrastRatio(cand, bg); r > bestRatio {
+			best, bestRatio = cand, r
+		}
+	}
+	return best
+}
@@ -0,0 +1,113 @@
+package palette
+
+import (
+	"github.com/lucasb-eyer/go-colorful"
+
+	"github.com/jaypipes/gt/types"
+)
+
+// Dracula (dark) and Alucard (light) color palette: https://draculatheme.com/contribute
+
+var (
+	DraculaDarker, _             = colorful.Hex("#191a21")
+	DraculaBackgroundDark, _     = colorful.Hex("#21222c")
+	DraculaBackground, _         = colorful.Hex("#282a36")
+	DraculaCurrentLine, _        = colorful.Hex("#44475a")
+	DraculaComment, _            = colorful.Hex("#6272a4")
+	DraculaForegroundDim, _      = colorful.Hex("#e2e2dc")
+	DraculaForeground, _         = colorful.Hex("#f8f8f2")
+	DraculaCyan, _               = colorful.Hex("#8be9fd")
+	DraculaGreen, _              = colorful.Hex("#50fa7b")
+	DraculaOrange, _             = colorful.Hex("#ffb86c")
+	DraculaPink, _               = colorful.Hex("#ff79c6")
+	DraculaPurple, _             = colorful.Hex("#bd93f9")
+	DraculaRed, _                = colorful.Hex("#ff5555")
+	DraculaYellow, _             = colorful.Hex("#f1fa8c")
+	DraculaAlucardBackground, _  = colorful.Hex("#fffbeb")
+	DraculaAlucardSurface, _     = colorful.Hex("#efeddc")
+	DraculaAlucardCurrentLine, _ = colorful.Hex("#cfcfde")
+	DraculaAlucardComment, _     = colorful.Hex("#6c664b")
+	DraculaAlucardForeground, _  = colorful.Hex("#1f1f1f")
+	DraculaAlucardCyan, _        = colorful.Hex("#036a96")
+	DraculaAlucardGreen, _       = colorful.Hex("#14710a")
+	DraculaAlucardOrange, _      = colorful.Hex("#a34d14")
+	DraculaAlucardPink, _        = colorful.Hex("#a3144d")
+	DraculaAlucardPurple, _      = colorful.Hex("#644ac9")
+	DraculaAlucardRed, _         = colorful.Hex("#cb3a2a")
+	DraculaAlucardYellow, _      = colorful.Hex("#846e15")
+
+	DraculaDarkColors = types.PaletteColors{
+		DraculaDarker,
+		DraculaBackgroundDark,
+		DraculaBackground,
+		DraculaCurrentLine,
+		DraculaComment,
+		DraculaForegroundDim,
+		DraculaForeground,
+		DraculaCyan,
+		DraculaPurple,
+		DraculaPink,
+		DraculaComment,
+		DraculaRed,
+		DraculaOrange,
+		DraculaYellow,
+		DraculaGreen,
+		DraculaPurple,
+	}
+
+	DraculaLightColors = types.PaletteColors{
+		DraculaAlucardForeground,
+		DraculaAlucardComment,
+		DraculaAlucardCurrentLine,
+		DraculaAlucardCurrentLine,
+		DraculaAlucardSurface,
+		DraculaAlucardSurface,
+		DraculaAlucardBackground,
+		DraculaAlucardCyan,
+		DraculaAlucardPurple,
+		DraculaAlucardPink,
+		DraculaAlucardComment,
+		DraculaAlucardRed,
+		DraculaAlucardOrange,
+		DraculaAlucardYellow,
+		DraculaAlucardGreen,
+		DraculaAlucardPurple,
+	}
+)
+
+var (
+	DraculaDark  = New(WithColors(DraculaDarkColors))
+	DraculaLight = New(WithColors(DraculaLightColors))
+
+	DraculaDarkScheme = &Scheme{
+		Palette:     DraculaDark,
+		Dark:        true,
+		Background:  DraculaBackground,
+		Surface:     DraculaCurrentLine,
+		Muted:       DraculaComment,
+		Foreground:  DraculaForeground,
+		Primary:     DraculaPurple,
+		OnPrimary:   DraculaBackground,
+		Secondary:   DraculaPink,
+		OnSecondary: DraculaBackground,
+		Accent:      DraculaCyan,
+		OnAccent:    DraculaBackground,
+		Error:       DraculaRed,
+	}
+
+	DraculaLightScheme = &Scheme{
+		Palette:     DraculaLight,
+		Dark:        false,
+		Background:  DraculaAlucardBackground,
+		Surface:     DraculaAlucardSurface,
+		Muted:       DraculaAlucardCurrentLine,
+		Foreground:  DraculaAlucardForeground,
+		Primary:     DraculaAlucardPurple,
+		OnPrimary:   DraculaAlucardBackground,
+		Secondary:   DraculaAlucardPink,
+		OnSecondary: DraculaAlucardBackground,
+		Accent:      DraculaAlucardCyan,
+		OnAccent:    DraculaAlucardBackground,
+		Error:       DraculaAlucardRed,
+	}
+)
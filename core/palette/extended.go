@@ -0,0 +1,130 @@
+package palette
+
+import (
+	"image/color"
+
+	"github.com/lucasb-eyer/go-colorful"
+
+	"github.com/jaypipes/gt/types"
+)
+
+// xtermColors are the 16 terminal colors used by xterm by default.
+var xtermColors = [16]types.Color{
+	color.RGBA{0x00, 0x00, 0x00, 0xff},
+	color.RGBA{0xcd, 0x00, 0x00, 0xff},
+	color.RGBA{0x00, 0xcd, 0x00, 0xff},
+	color.RGBA{0xcd, 0xcd, 0x00, 0xff},
+	color.RGBA{0x00, 0x00, 0xee, 0xff},
+	color.RGBA{0xcd, 0x00, 0xcd, 0xff},
+	color.RGBA{0x00, 0xcd, 0xcd, 0xff},
+	color.RGBA{0xe5, 0xe5, 0xe5, 0xff},
+	color.RGBA{0x7f, 0x7f, 0x7f, 0xff},
+	color.RGBA{0xff, 0x00, 0x00, 0xff},
+	color.RGBA{0x00, 0xff, 0x00, 0xff},
+	color.RGBA{0xff, 0xff, 0x00, 0xff},
+	color.RGBA{0x5c, 0x5c, 0xff, 0xff},
+	color.RGBA{0xff, 0x00, 0xff, 0xff},
+	color.RGBA{0x00, 0xff, 0xff, 0xff},
+	color.RGBA{0xff, 0xff, 0xff, 0xff},
+}
+
+// cubeLevels are the intensities of each channel in the xterm color cube.
+var cubeLevels = [6]uint8{0x00, 0x5f, 0x87, 0xaf, 0xd7, 0xff}
+
+// ANSIColors returns the 256 ANSI colors of an xterm-compatible terminal
+// having the supplied 16 terminal colors. The color cube and grayscale ramp
+// are the same on all xterm-compatible terminals.
+func ANSIColors(terminal [16]types.Color) types.PaletteANSIColors {
+	colors := types.PaletteANSIColors{}
+	copy(colors[:16], terminal[:])
+	for i := range 216 {
+		colors[16+i] = color.RGBA{
+			cubeLevels[i/36],
+			cubeLevels[(i/6)%6],
+			cubeLevels[i%6],
+			0xff,
+		}
+	}
+	for i := range 24 {
+		v := uint8(8 + 10*i)
+		colors[232+i] = color.RGBA{v, v, v, 0xff}
+	}
+	return colors
+}
+
+// Extended is a Palette that also describes the colors of a terminal: its
+// default foreground and background colors and the 256 colors addressable by
+// ANSI escape sequences.
+type Extended struct {
+	Palette
+	// ansi contains the 256 ANSI colors.
+	ansi types.PaletteANSIColors
+	// foreground is the terminal's default foreground color.
+	foreground types.Color
+	// background is the terminal's default background color.
+	background types.Color
+}
+
+// ANSIColors returns the Extended Palette's 256 ANSI colors.
+func (e *Extended) ANSIColors() types.PaletteANSIColors {
+	return e.ansi
+}
+
+// SetANSIColors sets the Extended Palette's 256 ANSI colors.
+func (e *Extended) SetANSIColors(colors types.PaletteANSIColors) {
+	e.ansi = colors
+}
+
+// ANSI returns the ANSI color at the specified index.
+func (e *Extended) ANSI(index int) types.Color {
+	index = min(max(0, index), 255)
+	return e.ansi[index]
+}
+
+// Foreground returns the terminal's default foreground color.
+func (e *Extended) Foreground() types.Color {
+	return e.foreground
+}
+
+// SetForeground sets the terminal's default foreground color.
+func (e *Extended) SetForeground(c types.Color) {
+	e.foreground = c
+}
+
+// Background returns the terminal's default background color.
+func (e *Extended) Background() types.Color {
+	return e.background
+}
+
+// SetBackground sets the terminal's default background color.
+func (e *Extended) SetBackground(c types.Color) {
+	e.background = c
+}
+
+// Dark returns true if the terminal's default background color is dark.
+func (e *Extended) Dark() bool {
+	return isDark(e.background)
+}
+
+// Scheme returns a new Scheme assigning the Extended Palette's colors to
+// roles suiting the terminal's default background color.
+func (e *Extended) Scheme() *Scheme {
+	s := NewScheme(e, e.Dark())
+	s.Background = e.background
+	s.Foreground = e.foreground
+	return s
+}
+
+// isDark returns true if the supplied color is closer to black than white.
+func isDark(c types.Color) bool {
+	l, _, _ := lab(c)
+	return l < 0.5
+}
+
+// lab returns the CIE L*a*b* coordinates of the supplied color.
+func lab(c types.Color) (float64, float64, float64) {
+	cc, _ := colorful.MakeColor(c)
+	return cc.Lab()
+}
+
+var _ types.ExtendedPalette = (*Extended)(nil)
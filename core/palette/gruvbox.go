@@ -0,0 +1,114 @@
+package palette
+
+import (
+	"github.com/lucasb-eyer/go-colorful"
+
+	"github.com/jaypipes/gt/types"
+)
+
+// Gruvbox color palette: https://github.com/morhetz/gruvbox
+
+var (
+	GruvboxDark0, _        = colorful.Hex("#282828")
+	GruvboxDark1, _        = colorful.Hex("#3c3836")
+	GruvboxDark2, _        = colorful.Hex("#504945")
+	GruvboxDark3, _        = colorful.Hex("#665c54")
+	GruvboxDark4, _        = colorful.Hex("#7c6f64")
+	GruvboxGray, _         = colorful.Hex("#928374")
+	GruvboxLight0, _       = colorful.Hex("#fbf1c7")
+	GruvboxLight1, _       = colorful.Hex("#ebdbb2")
+	GruvboxLight2, _       = colorful.Hex("#d5c4a1")
+	GruvboxLight3, _       = colorful.Hex("#bdae93")
+	GruvboxLight4, _       = colorful.Hex("#a89984")
+	GruvboxBrightRed, _    = colorful.Hex("#fb4934")
+	GruvboxBrightGreen, _  = colorful.Hex("#b8bb26")
+	GruvboxBrightYellow, _ = colorful.Hex("#fabd2f")
+	GruvboxBrightBlue, _   = colorful.Hex("#83a598")
+	GruvboxBrightPurple, _ = colorful.Hex("#d3869b")
+	GruvboxBrightAqua, _   = colorful.Hex("#8ec07c")
+	GruvboxBrightOrange, _ = colorful.Hex("#fe8019")
+	GruvboxNeutralBlue, _  = colorful.Hex("#458588")
+	GruvboxNeutralAqua, _  = colorful.Hex("#689d6a")
+	GruvboxFadedRed, _     = colorful.Hex("#9d0006")
+	GruvboxFadedGreen, _   = colorful.Hex("#79740e")
+	GruvboxFadedYellow, _  = colorful.Hex("#b57614")
+	GruvboxFadedBlue, _    = colorful.Hex("#076678")
+	GruvboxFadedPurple, _  = colorful.Hex("#8f3f71")
+	GruvboxFadedAqua, _    = colorful.Hex("#427b58")
+	GruvboxFadedOrange, _  = colorful.Hex("#af3a03")
+
+	GruvboxDarkColors = types.PaletteColors{
+		GruvboxDark0,
+		GruvboxDark1,
+		GruvboxDark2,
+		GruvboxDark3,
+		GruvboxLight4,
+		GruvboxLight2,
+		GruvboxLight1,
+		GruvboxBrightAqua,
+		GruvboxBrightBlue,
+		GruvboxNeutralBlue,
+		GruvboxNeutralAqua,
+		GruvboxBrightRed,
+		GruvboxBrightOrange,
+		GruvboxBrightYellow,
+		GruvboxBrightGreen,
+		GruvboxBrightPurple,
+	}
+
+	GruvboxLightColors = types.PaletteColors{
+		GruvboxDark1,
+		GruvboxDark2,
+		GruvboxDark3,
+		GruvboxDark4,
+		GruvboxLight3,
+		GruvboxLight1,
+		GruvboxLight0,
+		GruvboxFadedAqua,
+		GruvboxFadedBlue,
+		GruvboxNeutralBlue,
+		GruvboxNeutralAqua,
+		GruvboxFadedRed,
+		GruvboxFadedOrange,
+		GruvboxFadedYellow,
+		GruvboxFadedGreen,
+		GruvboxFadedPurple,
+	}
+)
+
+var (
+	GruvboxDark  = New(WithColors(GruvboxDarkColors))
+	GruvboxLight = New(WithColors(GruvboxLightColors))
+
+	GruvboxDarkScheme = &Scheme{
+		Palette:     GruvboxDark,
+		Dark:        true,
+		Background:  GruvboxDark0,
+		Surface:     GruvboxDark1,
+		Muted:       GruvboxDark3,
+		Foreground:  GruvboxLight1,
+		Primary:     GruvboxNeutralBlue,
+		OnPrimary:   GruvboxLight0,
+		Secondary:   GruvboxNeutralAqua,
+		OnSecondary: GruvboxDark0,
+		Accent:      GruvboxBrightYellow,
+		OnAccent:    GruvboxDark0,
+		Error:       GruvboxBrightRed,
+	}
+
+	GruvboxLightScheme = &Scheme{
+		Palette:     GruvboxLight,
+		Dark:        false,
+		Background:  GruvboxLight0,
+		Surface:     GruvboxLight1,
+		Muted:       GruvboxLight3,
+		Foreground:  GruvboxDark1,
+		Primary:     GruvboxFadedBlue,
+		OnPrimary:   GruvboxLight0,
+		Secondary:   GruvboxFadedAqua,
+		OnSecondary: GruvboxLight0,
+		Accent:      GruvboxFadedYellow,
+		OnAccent:    GruvboxLight0,
+		Error:       GruvboxFadedRed,
+	}
+)
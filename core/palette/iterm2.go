@@ -0,0 +1,91 @@
+package palette
+
+import (
+	"encoding/xml"
+	"fmt"
+	"image/color"
+	"strconv"
+	"strings"
+
+	"github.com/jaypipes/gt/types"
+)
+
+// plistNode is an element of an XML property list.
+type plistNode struct {
+	XMLName xml.Name
+	Content string      `xml:",chardata"`
+	Nodes   []plistNode `xml:",any"`
+}
+
+// entries returns the key/value pairs of a plist dict element.
+func (n plistNode) entries() map[string]plistNode {
+	entries := map[string]plistNode{}
+	for i := 0; i+1 < len(n.Nodes); i += 2 {
+		if n.Nodes[i].XMLName.Local == "key" {
+			entries[strings.TrimSpace(n.Nodes[i].Content)] = n.Nodes[i+1]
+		}
+	}
+	return entries
+}
+
+// loadITerm2 returns a new Extended Palette from an iTerm2 .itermcolors
+// property list.
+func loadITerm2(data []byte) (*Extended, error) {
+	var doc struct {
+		Dict plistNode `xml:"dict"`
+	}
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	entries := doc.Dict.entries()
+	get := func(name string) (types.Color, error) {
+		n, ok := entries[name]
+		if !ok {
+			return nil, fmt.Errorf("missing %q", name)
+		}
+		c, err := iTerm2Color(n)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		return c, nil
+	}
+	terminal := [16]types.Color{}
+	for i := range terminal {
+		c, err := get(fmt.Sprintf("Ansi %d Color", i))
+		if err != nil {
+			return nil, err
+		}
+		terminal[i] = c
+	}
+	fg, err := get("Foreground Color")
+	if err != nil {
+		return nil, err
+	}
+	bg, err := get("Background Color")
+	if err != nil {
+		return nil, err
+	}
+	return fromTerminal(terminal, fg, bg), nil
+}
+
+// iTerm2Color returns the color described by a plist dict having "Red
+// Component", "Green Component" and "Blue Component" real values between 0
+// and 1.
+func iTerm2Color(n plistNode) (types.Color, error) {
+	entries := n.entries()
+	rgb := [3]uint8{}
+	for i, name := range []string{
+		"Red Component", "Green Component", "Blue Component",
+	} {
+		v, ok := entries[name]
+		if !ok {
+			return nil, fmt.Errorf("missing %q", name)
+		}
+		f, err := strconv.ParseFloat(strings.TrimSpace(v.Content), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		rgb[i] = uint8(min(max(f, 0), 1)*255 + 0.5)
+	}
+	return color.RGBA{rgb[0], rgb[1], rgb[2], 0xff}, nil
+}
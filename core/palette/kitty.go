@@ -0,0 +1,60 @@
+package palette
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jaypipes/gt/types"
+)
+
+// loadKitty returns a new Extended Palette from the color settings in a
+// kitty config or theme file. Terminal colors not set in the file are the
+// xterm defaults.
+func loadKitty(data []byte) (*Extended, error) {
+	terminal := xtermColors
+	var fg, bg types.Color
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	line := 0
+	for scanner.Scan() {
+		line++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		key, value := fields[0], fields[1]
+		var dest *types.Color
+		switch {
+		case key == "foreground":
+			dest = &fg
+		case key == "background":
+			dest = &bg
+		case strings.HasPrefix(key, "color"):
+			i, err := strconv.Atoi(strings.TrimPrefix(key, "color"))
+			if err != nil || i < 0 || i >= len(terminal) {
+				// colors beyond 15 are in the fixed xterm cube and ramp.
+				continue
+			}
+			dest = &terminal[i]
+		default:
+			continue
+		}
+		c, err := parseHex(value)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s: %w", line, key, err)
+		}
+		*dest = c
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if fg == nil {
+		fg = terminal[7]
+	}
+	if bg == nil {
+		bg = terminal[0]
+	}
+	return fromTerminal(terminal, fg, bg), nil
+}
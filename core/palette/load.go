@@ -0,0 +1,156 @@
+package palette
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/lucasb-eyer/go-colorful"
+
+	"github.com/jaypipes/gt/types"
+)
+
+// Format is the file format of a color scheme file.
+type Format string
+
+const (
+	// FormatBase16 is a base16 or base24 YAML scheme, in either the classic
+	// format with top-level baseXX keys or the newer format with a palette
+	// map.
+	FormatBase16 Format = "base16"
+	// FormatITerm2 is an iTerm2 .itermcolors property list.
+	FormatITerm2 Format = "iterm2"
+	// FormatAlacritty is an Alacritty TOML config file.
+	FormatAlacritty Format = "alacritty"
+	// FormatKitty is a kitty config or theme file.
+	FormatKitty Format = "kitty"
+)
+
+// FormatFromPath returns the Format of the color scheme file at the supplied
+// path, based on the file's extension.
+func FormatFromPath(path string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return FormatBase16, nil
+	case ".itermcolors":
+		return FormatITerm2, nil
+	case ".toml":
+		return FormatAlacritty, nil
+	case ".conf":
+		return FormatKitty, nil
+	}
+	return "", fmt.Errorf("unknown color scheme file format for %q", path)
+}
+
+// LoadFile returns a new Extended Palette built from the color scheme file at
+// the supplied path. The file's format is determined from its extension.
+func LoadFile(path string) (*Extended, error) {
+	format, err := FormatFromPath(path)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	e, err := Load(f, format)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return e, nil
+}
+
+// Load returns a new Extended Palette built from the color scheme file
+// contents in the supplied format read from the supplied reader.
+func Load(r io.Reader, format Format) (*Extended, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	switch format {
+	case FormatBase16:
+		return loadBase16(data)
+	case FormatITerm2:
+		return loadITerm2(data)
+	case FormatAlacritty:
+		return loadAlacritty(data)
+	case FormatKitty:
+		return loadKitty(data)
+	}
+	return nil, fmt.Errorf("unknown color scheme file format %q", format)
+}
+
+// fromTerminal returns a new Extended Palette for a terminal having the
+// supplied 16 terminal colors and default foreground and background colors.
+//
+// The Palette's grayscale colors are the terminal's background, foreground,
+// black and white colors, ordered from darkest to lightest. Since terminals
+// have no orange, the Palette's orange is a blend of red and yellow.
+func fromTerminal(terminal [16]types.Color, fg, bg types.Color) *Extended {
+	colors := types.PaletteColors{}
+	copy(colors[:7], byLightness(
+		bg,
+		terminal[0],
+		terminal[8],
+		blend(terminal[8], terminal[7]),
+		terminal[7],
+		fg,
+		terminal[15],
+	))
+	colors[7] = terminal[6]
+	colors[8] = terminal[14]
+	colors[9] = terminal[12]
+	colors[10] = terminal[4]
+	colors[11] = terminal[1]
+	colors[12] = blend(terminal[1], terminal[3])
+	colors[13] = terminal[3]
+	colors[14] = terminal[2]
+	colors[15] = terminal[5]
+	return NewExtended(
+		WithColors(colors),
+		WithANSIColors(ANSIColors(terminal)),
+		WithForeground(fg),
+		WithBackground(bg),
+	)
+}
+
+// byLightness returns the supplied colors ordered from darkest to lightest.
+func byLightness(colors ...types.Color) []types.Color {
+	for i := 1; i < len(colors); i++ {
+		for j := i; j > 0; j-- {
+			lj, _, _ := lab(colors[j])
+			lp, _, _ := lab(colors[j-1])
+			if lj >= lp {
+				break
+			}
+			colors[j], colors[j-1] = colors[j-1], colors[j]
+		}
+	}
+	return colors
+}
+
+// blend returns the color halfway between the supplied colors.
+func blend(a, b types.Color) types.Color {
+	ca, _ := colorful.MakeColor(a)
+	cb, _ := colorful.MakeColor(b)
+	return ca.BlendLab(cb, 0.5).Clamped()
+}
+
+// parseHex parses a hex color, with or without a leading "#" or "0x".
+func parseHex(s string) (types.Color, error) {
+	s = strings.Trim(strings.TrimSpace(s), `"'`)
+	switch {
+	case strings.HasPrefix(s, "0x"), strings.HasPrefix(s, "0X"):
+		s = "#" + s[2:]
+	case !strings.HasPrefix(s, "#"):
+		s = "#" + s
+	}
+	c, err := colorful.Hex(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid color %q", s)
+	}
+	return c, nil
+}
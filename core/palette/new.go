@@ -22,3 +22,51 @@ func WithColors(colors types.PaletteColors) types.PaletteWithOption {
 		p.SetColors(colors)
 	}
 }
+
+// NewExtended returns a new instance of an Extended Palette. Unless
+// overridden, its ANSI colors are the xterm defaults and its foreground and
+// background colors are white and black.
+//
+// You can pass zero or more PaletteWithOptions to optionally set certain
+// attributes on the returned Extended Palette.
+func NewExtended(opts ...types.PaletteWithOption) *Extended {
+	e := &Extended{
+		ansi:       ANSIColors(xtermColors),
+		foreground: xtermColors[7],
+		background: xtermColors[0],
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// WithANSIColors sets the 256 ANSI colors of an ExtendedPalette. It has no
+// effect on other Palettes.
+func WithANSIColors(colors types.PaletteANSIColors) types.PaletteWithOption {
+	return func(p types.Palette) {
+		if e, ok := p.(types.ExtendedPalette); ok {
+			e.SetANSIColors(colors)
+		}
+	}
+}
+
+// WithForeground sets the default foreground color of an ExtendedPalette. It
+// has no effect on other Palettes.
+func WithForeground(c types.Color) types.PaletteWithOption {
+	return func(p types.Palette) {
+		if e, ok := p.(types.ExtendedPalette); ok {
+			e.SetForeground(c)
+		}
+	}
+}
+
+// WithBackground sets the default background color of an ExtendedPalette. It
+// has no effect on other Palettes.
+func WithBackground(c types.Color) types.PaletteWithOption {
+	return func(p types.Palette) {
+		if e, ok := p.(types.ExtendedPalette); ok {
+			e.SetBackground(c)
+		}
+	}
+}
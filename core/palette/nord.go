@@ -61,4 +61,36 @@ var (
 
 var (
 	Nord = New(WithColors(NordColors))
+
+	NordDarkScheme = &Scheme{
+		Palette:     Nord,
+		Dark:        true,
+		Background:  Nord0,
+		Surface:     Nord1,
+		Muted:       Nord3,
+		Foreground:  Nord4,
+		Primary:     Nord10,
+		OnPrimary:   Nord6,
+		Secondary:   Nord9,
+		OnSecondary: Nord0,
+		Accent:      Nord8,
+		OnAccent:    Nord0,
+		Error:       Nord11,
+	}
+
+	NordLightScheme = &Scheme{
+		Palette:     Nord,
+		Dark:        false,
+		Background:  Nord6,
+		Surface:     Nord5,
+		Muted:       Nord4,
+		Foreground:  Nord3,
+		Primary:     Nord10,
+		OnPrimary:   Nord6,
+		Secondary:   Nord9,
+		OnSecondary: Nord0,
+		Accent:      Nord8,
+		OnAccent:    Nord0,
+		Error:       Nord11,
+	}
 )
@@ -0,0 +1,81 @@
+package palette
+
+import (
+	"github.com/jaypipes/gt/types"
+)
+
+// Scheme assigns colors from a Palette to the roles they play when styling
+// things on either a dark or a light terminal background.
+type Scheme struct {
+	// Palette is the Palette the Scheme's colors come from.
+	Palette types.Palette
+	// Dark is true if the Scheme is meant for dark terminal backgrounds.
+	Dark bool
+	// Background is the color of the terminal background.
+	Background types.Color
+	// Surface is the background color of things drawn on the terminal
+	// background, like input fields and navigation bars.
+	Surface types.Color
+	// Muted is the color of de-emphasized things, like disabled text and
+	// unfocused borders.
+	Muted types.Color
+	// Foreground is the color of normal text.
+	Foreground types.Color
+	// Primary is the background color of primary things, like buttons.
+	Primary types.Color
+	// OnPrimary is the color of text drawn on the Primary color.
+	OnPrimary types.Color
+	// Secondary is the background color of secondary things.
+	Secondary types.Color
+	// OnSecondary is the color of text drawn on the Secondary color.
+	OnSecondary types.Color
+	// Accent is the color used to highlight the thing having the focus.
+	Accent types.Color
+	// OnAccent is the color of text drawn on the Accent color.
+	OnAccent types.Color
+	// Error is the color of errors and invalid input.
+	Error types.Color
+}
+
+// NewScheme returns a new Scheme assigning colors from the supplied Palette
+// to roles suiting a dark terminal background if dark is true, or a light
+// terminal background otherwise.
+//
+// The Palette's grayscale colors provide the Background, Surface, Muted and
+// Foreground roles. The Palette's dark blue, blue green and ice blue colors
+// (indexes 10, 7 and 8, as in the Nord palette) provide the Primary,
+// Secondary and Accent roles and its red color (index 11) the Error role.
+func NewScheme(p types.Palette, dark bool) *Scheme {
+	s := &Scheme{
+		Palette:   p,
+		Dark:      dark,
+		Primary:   p.Color(10),
+		Secondary: p.Color(7),
+		Accent:    p.Color(8),
+		Error:     p.Color(11),
+	}
+	if dark {
+		s.Background = p.Grayscale(0)
+		s.Surface = p.Grayscale(1)
+		s.Muted = p.Grayscale(3)
+		s.Foreground = p.Grayscale(4)
+	} else {
+		s.Background = p.Grayscale(6)
+		s.Surface = p.Grayscale(5)
+		s.Muted = p.Grayscale(4)
+		s.Foreground = p.Grayscale(1)
+	}
+	s.OnPrimary = contrasting(s.Primary, p)
+	s.OnSecondary = contrasting(s.Secondary, p)
+	s.OnAccent = contrasting(s.Accent, p)
+	return s
+}
+
+// contrasting returns whichever of the Palette's darkest and lightest
+// grayscale colors is more legible on the supplied background color.
+func contrasting(bg types.Color, p types.Palette) types.Color {
+	if isDark(bg) {
+		return p.Grayscale(6)
+	}
+	return p.Grayscale(0)
+}
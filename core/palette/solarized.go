@@ -0,0 +1,103 @@
+package palette
+
+import (
+	"github.com/lucasb-eyer/go-colorful"
+
+	"github.com/jaypipes/gt/types"
+)
+
+// Solarized color palette: https://ethanschoonover.com/solarized/
+
+var (
+	SolarizedBase03, _  = colorful.Hex("#002b36")
+	SolarizedBase02, _  = colorful.Hex("#073642")
+	SolarizedBase01, _  = colorful.Hex("#586e75")
+	SolarizedBase00, _  = colorful.Hex("#657b83")
+	SolarizedBase0, _   = colorful.Hex("#839496")
+	SolarizedBase1, _   = colorful.Hex("#93a1a1")
+	SolarizedBase2, _   = colorful.Hex("#eee8d5")
+	SolarizedBase3, _   = colorful.Hex("#fdf6e3")
+	SolarizedYellow, _  = colorful.Hex("#b58900")
+	SolarizedOrange, _  = colorful.Hex("#cb4b16")
+	SolarizedRed, _     = colorful.Hex("#dc322f")
+	SolarizedMagenta, _ = colorful.Hex("#d33682")
+	SolarizedViolet, _  = colorful.Hex("#6c71c4")
+	SolarizedBlue, _    = colorful.Hex("#268bd2")
+	SolarizedCyan, _    = colorful.Hex("#2aa198")
+	SolarizedGreen, _   = colorful.Hex("#859900")
+
+	SolarizedDarkColors = types.PaletteColors{
+		SolarizedBase03,
+		SolarizedBase02,
+		SolarizedBase01,
+		SolarizedBase00,
+		SolarizedBase0,
+		SolarizedBase1,
+		SolarizedBase2,
+		SolarizedCyan,
+		SolarizedBlue,
+		SolarizedViolet,
+		SolarizedMagenta,
+		SolarizedRed,
+		SolarizedOrange,
+		SolarizedYellow,
+		SolarizedGreen,
+		SolarizedViolet,
+	}
+
+	SolarizedLightColors = types.PaletteColors{
+		SolarizedBase02,
+		SolarizedBase01,
+		SolarizedBase00,
+		SolarizedBase0,
+		SolarizedBase1,
+		SolarizedBase2,
+		SolarizedBase3,
+		SolarizedCyan,
+		SolarizedBlue,
+		SolarizedViolet,
+		SolarizedMagenta,
+		SolarizedRed,
+		SolarizedOrange,
+		SolarizedYellow,
+		SolarizedGreen,
+		SolarizedViolet,
+	}
+)
+
+var (
+	SolarizedDark  = New(WithColors(SolarizedDarkColors))
+	SolarizedLight = New(WithColors(SolarizedLightColors))
+
+	SolarizedDarkScheme = &Scheme{
+		Palette:     SolarizedDark,
+		Dark:        true,
+		Background:  SolarizedBase03,
+		Surface:     SolarizedBase02,
+		Muted:       SolarizedBase01,
+		Foreground:  SolarizedBase0,
+		Primary:     SolarizedBlue,
+		OnPrimary:   SolarizedBase3,
+		Secondary:   SolarizedCyan,
+		OnSecondary: SolarizedBase03,
+		Accent:      SolarizedYellow,
+		OnAccent:    SolarizedBase03,
+		Error:       SolarizedRed,
+	}
+
+	SolarizedLightScheme = &Scheme{
+		Palette:     SolarizedLight,
+		Dark:        false,
+		Background:  SolarizedBase3,
+		Surface:     SolarizedBase2,
+		Muted:       SolarizedBase1,
+		Foreground:  SolarizedBase00,
+		Primary:     SolarizedBlue,
+		OnPrimary:   SolarizedBase3,
+		Secondary:   SolarizedCyan,
+		OnSecondary: SolarizedBase3,
+		Accent:      SolarizedYellow,
+		OnAccent:    SolarizedBase3,
+		Error:       SolarizedRed,
+	}
+)
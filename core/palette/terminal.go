@@ -8,11 +8,16 @@ import (
 
 var (
 	// TerminalHasDarkBackground will be true if we detect the default terminal
-	// has a dark background.
+	// has a dark background. It is only accurate after DetectDarkBackground
+	// has been called.
 	TerminalHasDarkBackground = true
 )
 
-func init() {
+// DetectDarkBackground queries the terminal attached to stdout for its
+// background color, records the result in TerminalHasDarkBackground and
+// returns it.
+func DetectDarkBackground() bool {
 	o := termenv.NewOutput(os.Stdout)
 	TerminalHasDarkBackground = o.HasDarkBackground()
+	return TerminalHasDarkBackground
 }
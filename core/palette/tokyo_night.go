@@ -0,0 +1,119 @@
+package palette
+
+import (
+	"github.com/lucasb-eyer/go-colorful"
+
+	"github.com/jaypipes/gt/types"
+)
+
+// Tokyo Night (dark) and Tokyo Night Day (light) color palette: https://github.com/folke/tokyonight.nvim
+
+var (
+	TokyoNightNightBackgroundDark, _ = colorful.Hex("#16161e")
+	TokyoNightNightBackground, _     = colorful.Hex("#1a1b26")
+	TokyoNightNightHighlight, _      = colorful.Hex("#292e42")
+	TokyoNightNightTerminalBlack, _  = colorful.Hex("#414868")
+	TokyoNightNightComment, _        = colorful.Hex("#565f89")
+	TokyoNightNightForegroundDark, _ = colorful.Hex("#a9b1d6")
+	TokyoNightNightForeground, _     = colorful.Hex("#c0caf5")
+	TokyoNightNightTeal, _           = colorful.Hex("#1abc9c")
+	TokyoNightNightCyan, _           = colorful.Hex("#7dcfff")
+	TokyoNightNightBlue, _           = colorful.Hex("#7aa2f7")
+	TokyoNightNightBlue0, _          = colorful.Hex("#3d59a1")
+	TokyoNightNightRed, _            = colorful.Hex("#f7768e")
+	TokyoNightNightOrange, _         = colorful.Hex("#ff9e64")
+	TokyoNightNightYellow, _         = colorful.Hex("#e0af68")
+	TokyoNightNightGreen, _          = colorful.Hex("#9ece6a")
+	TokyoNightNightMagenta, _        = colorful.Hex("#bb9af7")
+	TokyoNightDayForeground, _       = colorful.Hex("#3760bf")
+	TokyoNightDayForegroundDark, _   = colorful.Hex("#6172b0")
+	TokyoNightDayComment, _          = colorful.Hex("#848cb5")
+	TokyoNightDayTerminalBlack, _    = colorful.Hex("#a1a6c5")
+	TokyoNightDayHighlight, _        = colorful.Hex("#c4c8da")
+	TokyoNightDayBackgroundDark, _   = colorful.Hex("#d0d5e3")
+	TokyoNightDayBackground, _       = colorful.Hex("#e1e2e7")
+	TokyoNightDayTeal, _             = colorful.Hex("#118c74")
+	TokyoNightDayCyan, _             = colorful.Hex("#007197")
+	TokyoNightDayBlue, _             = colorful.Hex("#2e7de9")
+	TokyoNightDayBlue0, _            = colorful.Hex("#7890dd")
+	TokyoNightDayRed, _              = colorful.Hex("#f52a65")
+	TokyoNightDayOrange, _           = colorful.Hex("#b15c00")
+	TokyoNightDayYellow, _           = colorful.Hex("#8c6c3e")
+	TokyoNightDayGreen, _            = colorful.Hex("#587539")
+	TokyoNightDayMagenta, _          = colorful.Hex("#9854f1")
+
+	TokyoNightDarkColors = types.PaletteColors{
+		TokyoNightNightBackgroundDark,
+		TokyoNightNightBackground,
+		TokyoNightNightHighlight,
+		TokyoNightNightTerminalBlack,
+		TokyoNightNightComment,
+		TokyoNightNightForegroundDark,
+		TokyoNightNightForeground,
+		TokyoNightNightTeal,
+		TokyoNightNightCyan,
+		TokyoNightNightBlue,
+		TokyoNightNightBlue0,
+		TokyoNightNightRed,
+		TokyoNightNightOrange,
+		TokyoNightNightYellow,
+		TokyoNightNightGreen,
+		TokyoNightNightMagenta,
+	}
+
+	TokyoNightLightColors = types.PaletteColors{
+		TokyoNightDayForeground,
+		TokyoNightDayForegroundDark,
+		TokyoNightDayComment,
+		TokyoNightDayTerminalBlack,
+		TokyoNightDayHighlight,
+		TokyoNightDayBackgroundDark,
+		TokyoNightDayBackground,
+		TokyoNightDayTeal,
+		TokyoNightDayCyan,
+		TokyoNightDayBlue,
+		TokyoNightDayBlue0,
+		TokyoNightDayRed,
+		TokyoNightDayOrange,
+		TokyoNightDayYellow,
+		TokyoNightDayGreen,
+		TokyoNightDayMagenta,
+	}
+)
+
+var (
+	TokyoNightDark  = New(WithColors(TokyoNightDarkColors))
+	TokyoNightLight = New(WithColors(TokyoNightLightColors))
+
+	TokyoNightDarkScheme = &Scheme{
+		Palette:     TokyoNightDark,
+		Dark:        true,
+		Background:  TokyoNightNightBackground,
+		Surface:     TokyoNightNightHighlight,
+		Muted:       TokyoNightNightComment,
+		Foreground:  TokyoNightNightForeground,
+		Primary:     TokyoNightNightBlue0,
+		OnPrimary:   TokyoNightNightForeground,
+		Secondary:   TokyoNightNightMagenta,
+		OnSecondary: TokyoNightNightBackground,
+		Accent:      TokyoNightNightCyan,
+		OnAccent:    TokyoNightNightBackground,
+		Error:       TokyoNightNightRed,
+	}
+
+	TokyoNightLightScheme = &Scheme{
+		Palette:     TokyoNightLight,
+		Dark:        false,
+		Background:  TokyoNightDayBackground,
+		Surface:     TokyoNightDayBackgroundDark,
+		Muted:       TokyoNightDayComment,
+		Foreground:  TokyoNightDayForeground,
+		Primary:     TokyoNightDayBlue,
+		OnPrimary:   TokyoNightDayBackground,
+		Secondary:   TokyoNightDayMagenta,
+		OnSecondary: TokyoNightDayBackground,
+		Accent:      TokyoNightDayCyan,
+		OnAccent:    TokyoNightDayBackground,
+		Error:       TokyoNightDayRed,
+	}
+)
@@ -51,15 +51,8 @@ func Align(
 		} else if align&types.AlignmentMiddle != 0 {
 			// pad the string with new lines at the top and bottom of the bounding
 			// box
-			linesToPadTop := linesToPad / 2
-			linesToPadBottom := linesToPad / 2
-			// handle overflow/underflow from integer division rounding...
-			totalLines := numLines + linesToPadTop + linesToPadBottom
-			if totalLines > height {
-				linesToPadTop--
-			} else if totalLines < height {
-				linesToPadBottom++
-			}
+			linesToPadTop := PadTop(numLines, height, align)
+			linesToPadBottom := linesToPad - linesToPadTop
 			b.WriteString(strings.Repeat("\n", linesToPadTop))
 			b.WriteString(content)
 			b.WriteString(strings.Repeat("\n", linesToPadBottom))
@@ -82,15 +75,8 @@ func Align(
 				b.WriteString(strings.Repeat(" ", cellsToPad))
 				b.WriteString(line)
 			} else if align&types.AlignmentCenter != 0 {
-				cellsToPadLeft := cellsToPad / 2
-				cellsToPadRight := cellsToPad / 2
-				// handle overflow/underflow from integer division rounding...
-				totalCells := numCells + cellsToPadLeft + cellsToPadRight
-				if totalCells > width {
-					cellsToPadRight--
-				} else if totalCells < width {
-					cellsToPadRight++
-				}
+				cellsToPadLeft := PadLeft(numCells, width, align)
+				cellsToPadRight := cellsToPad - cellsToPadLeft
 				b.WriteString(strings.Repeat(" ", cellsToPadLeft))
 				b.WriteString(line)
 				b.WriteString(strings.Repeat(" ", cellsToPadRight))
@@ -108,3 +94,33 @@ func Align(
 
 	return b.String()
 }
+
+// PadTop returns the number of blank lines that Align places above content of
+// the supplied number of lines within a bounding box of the supplied height.
+func PadTop(numLines, height int, align types.Alignment) int {
+	linesToPad := height - numLines
+	if linesToPad <= 0 {
+		return 0
+	}
+	if align&types.AlignmentBottom != 0 {
+		return linesToPad
+	} else if align&types.AlignmentMiddle != 0 {
+		return linesToPad / 2
+	}
+	return 0
+}
+
+// PadLeft returns the number of spaces that Align places before a line of the
+// supplied number of cells within a bounding box of the supplied width.
+func PadLeft(numCells, width int, align types.Alignment) int {
+	cellsToPad := width - numCells
+	if cellsToPad <= 0 {
+		return 0
+	}
+	if align&types.AlignmentRight != 0 {
+		return cellsToPad
+	} else if align&types.AlignmentCenter != 0 {
+		return cellsToPad / 2
+	}
+	return 0
+}
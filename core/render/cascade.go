@@ -0,0 +1,22 @@
+package render
+
+import (
+	"context"
+
+	"github.com/jaypipes/gt/types"
+)
+
+// Cascade calls Cascade on the supplied Node, if it is Cascadable, and on all
+// of the Node's descendants.
+func Cascade(
+	ctx context.Context,
+	n types.Node,
+) {
+	c, ok := n.(types.Cascadable)
+	if ok {
+		c.Cascade(ctx)
+	}
+	for _, child := range n.Children() {
+		Cascade(ctx, child)
+	}
+}
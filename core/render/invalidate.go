@@ -0,0 +1,23 @@
+package render
+
+import (
+	"context"
+
+	"github.com/jaypipes/gt/types"
+)
+
+// Invalidate calls Invalidate on the supplied Node, if it is Plottable, and on
+// all of the Node's descendants so that the layout of the whole tree is
+// calculated again the next time it is plotted.
+func Invalidate(
+	ctx context.Context,
+	n types.Node,
+) {
+	p, ok := n.(types.Plottable)
+	if ok {
+		p.Invalidate()
+	}
+	for _, child := range n.Children() {
+		Invalidate(ctx, child)
+	}
+}
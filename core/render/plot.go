@@ -164,7 +164,7 @@ func Plot(
 			"render.Plot[%s]: calculated bounds %s",
 			core.ID(n), bounds,
 		)
-		p.SetBounds(bounds)
+		p.SetPlottedBounds(bounds)
 	}
 	inner := p.InnerBounds()
 	for _, child := range n.Children() {
@@ -267,6 +267,7 @@ func calculateBounds(
 			)
 			anchor.Y = nextY
 		}
+		anchor = collapseAnchor(n, anchor, display)
 	}
 
 	bounds := types.Rectangle{}
@@ -330,6 +331,119 @@ func calculateBounds(
 	return bounds
 }
 
+// collapseAnchor returns the supplied anchor point moved so that the
+// supplied Node's border shares a line with the border of the adjacent
+// previous sibling, if the Node's parent collapses the borders of its
+// children. Inline Nodes share their left edge with the right edge of the
+// previous sibling. Block Nodes share their top edge with the bottom edge of
+// the previous sibling above them.
+func collapseAnchor(
+	n types.Node,
+	anchor types.Point,
+	display types.Display,
+) types.Point {
+	parent, ok := n.Parent().(types.Borderable)
+	if !ok || !parent.BorderCollapse() {
+		return anchor
+	}
+	border := nodeBorder(n)
+	if border == nil {
+		return anchor
+	}
+	if display != types.DisplayBlock {
+		if collapsesLeft(n) {
+			anchor.X--
+		}
+		return anchor
+	}
+	if border.TSize() == 0 {
+		return anchor
+	}
+	for _, prevNode := range n.PreviousSiblings() {
+		prev, ok := prevNode.(types.Plottable)
+		if !ok || prev.MaxY() != anchor.Y {
+			continue
+		}
+		if pb := nodeBorder(prevNode); pb != nil && pb.BSize() > 0 {
+			anchor.Y--
+			break
+		}
+	}
+	return anchor
+}
+
+// collapsesLeft returns whether the left edge of the supplied Node's border
+// shares a line with the right edge of its previous sibling's border.
+func collapsesLeft(n types.Node) bool {
+	border := nodeBorder(n)
+	prev := nodeBorder(n.PreviousSibling())
+	return border != nil && prev != nil &&
+		prev.RSize() > 0 && border.LSize() > 0
+}
+
+// collapsedSize returns the outer width and height consumed by the children
+// of the supplied Node when the Node collapses the borders of its children.
+// Each border line shared by adjacent children is counted once, so the Node
+// snugs to its children instead of keeping a trailing blank column or line
+// for every collapsed overlap. ok is false if the Node does not collapse the
+// borders of its children or if any child lacks a fixed width and height.
+func collapsedSize(n types.Node) (width, height types.Dimension, ok bool) {
+	b, isBorderable := n.(types.Borderable)
+	if !isBorderable || !b.BorderCollapse() {
+		return 0, 0, false
+	}
+	children := n.Children()
+	if len(children) == 0 {
+		return 0, 0, false
+	}
+	rowWidth := types.Dimension(0)
+	rowHeight := types.Dimension(0)
+	// rowBottom is whether the tallest child in the row has a bottom border
+	// that the next row's top border collapses into.
+	rowBottom := false
+	for x, childNode := range children {
+		child, isPlottable := childNode.(types.Plottable)
+		if !isPlottable || !child.HasFixedWidth() || !child.HasFixedHeight() {
+			return 0, 0, false
+		}
+		border := nodeBorder(childNode)
+		if x > 0 && child.Display() == types.DisplayBlock {
+			// The child starts a new row below the tallest child in the
+			// previous row.
+			width = max(width, rowWidth)
+			height += rowHeight
+			if rowBottom && border != nil && border.TSize() > 0 {
+				height--
+			}
+			rowWidth, rowHeight, rowBottom = 0, 0, false
+		} else if x > 0 && collapsesLeft(childNode) {
+			rowWidth--
+		}
+		rowWidth += child.FixedWidth() + child.HorizontalSpace()
+		childHeight := child.FixedHeight() + child.VerticalSpace()
+		hasBottom := border != nil && border.BSize() > 0
+		switch {
+		case childHeight > rowHeight:
+			rowHeight = childHeight
+			rowBottom = hasBottom
+		case childHeight == rowHeight:
+			rowBottom = rowBottom || hasBottom
+		}
+	}
+	width = max(width, rowWidth)
+	height += rowHeight
+	return width, height, true
+}
+
+// nodeBorder returns the Border of the supplied Node, if any.
+func nodeBorder(n types.Node) types.Border {
+	b, ok := n.(types.Borderable)
+	if !ok {
+		return nil
+	}
+	return b.Border()
+}
+
 // NextLineY returns the maximum Y value of any previous sibling, or if
 // no siblings, the parent inner bounds top-left coordinate's Y valub.
 func NextLineY(n types.Node) int {
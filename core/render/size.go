@@ -48,6 +48,19 @@ func Width(ctx context.Context, n types.Node) types.Dimension {
 		return calcWidth
 	}
 
+	if cw, _, ok := collapsedSize(n); ok && display != types.DisplayInline &&
+		!p.HasPercentWidth() {
+		calcWidth := cw + horizSpace
+		gtlog.Debug(
+			ctx,
+			"render.Width[%s]: display=%s "+
+				"collapsed_children_width=%d horiz_space=%d. "+
+				"calculated width of %d",
+			id, display, cw, horizSpace, calcWidth,
+		)
+		return calcWidth
+	}
+
 	parentNode := n.Parent()
 	if parentNode == nil {
 		width := types.Dimension(p.Bounds().Dx())
@@ -295,6 +308,19 @@ func Height(ctx context.Context, n types.Node) types.Dimension {
 		return calcHeight
 	}
 
+	if _, ch, ok := collapsedSize(n); ok && display != types.DisplayInline &&
+		!p.HasPercentHeight() {
+		calcHeight := ch + vertSpace
+		gtlog.Debug(
+			ctx,
+			"render.Height[%s]: display=%s "+
+				"collapsed_children_height=%d vert_space=%d. "+
+				"calculated height of %d",
+			id, display, ch, vertSpace, calcHeight,
+		)
+		return calcHeight
+	}
+
 	parentNode := n.Parent()
 	if parentNode == nil {
 		height := types.Dimension(p.Bounds().Dy())
@@ -0,0 +1,109 @@
+package session
+
+import (
+	"time"
+
+	"github.com/gdamore/tcell/v3"
+)
+
+// EventType is the kind of a recorded Event.
+type EventType string
+
+const (
+	EventTypeKey    EventType = "key"
+	EventTypeMouse  EventType = "mouse"
+	EventTypePaste  EventType = "paste"
+	EventTypeResize EventType = "resize"
+	EventTypeFocus  EventType = "focus"
+	// EventTypeBackground records whether the terminal has a dark
+	// background. It is not an input event and is not replayed as one.
+	EventTypeBackground EventType = "background"
+)
+
+// Event is an input event received by an Application, serialized as one line
+// of a session file.
+type Event struct {
+	// At is how long after the start of the session the Event was received.
+	At time.Duration `json:"at"`
+	// Type is the kind of the Event.
+	Type EventType `json:"type"`
+	// Name is a human-readable description of a key Event, e.g. "Ctrl+S".
+	// It is informational only and ignored on replay.
+	Name string `json:"name,omitempty"`
+	// Key is the tcell.Key of a key Event.
+	Key tcell.Key `json:"key,omitempty"`
+	// Str is the text of a key Event for tcell.KeyRune.
+	Str string `json:"str,omitempty"`
+	// Mod contains the modifiers held during a key or mouse Event.
+	Mod tcell.ModMask `json:"mod,omitempty"`
+	// X is the column of a mouse Event.
+	X int `json:"x,omitempty"`
+	// Y is the row of a mouse Event.
+	Y int `json:"y,omitempty"`
+	// Buttons contains the buttons pressed, or the wheel direction, of a
+	// mouse Event.
+	Buttons tcell.ButtonMask `json:"buttons,omitempty"`
+	// Width is the new width of the screen of a resize Event.
+	Width int `json:"width,omitempty"`
+	// Height is the new height of the screen of a resize Event.
+	Height int `json:"height,omitempty"`
+	// Start is true for the paste Event starting a bracketed paste and false
+	// for the one ending it.
+	Start bool `json:"start,omitempty"`
+	// Focused is true for a focus Event when the terminal gained the focus
+	// and false when it lost it.
+	Focused bool `json:"focused,omitempty"`
+	// Dark is true for a background Event when the terminal has a dark
+	// background.
+	Dark bool `json:"dark,omitempty"`
+}
+
+// FromTCell returns an Event for the supplied tcell.Event received the
+// supplied duration after the start of the session, and whether the
+// tcell.Event is a kind of input event that is recorded.
+func FromTCell(at time.Duration, ev tcell.Event) (Event, bool) {
+	e := Event{At: at}
+	switch ev := ev.(type) {
+	case *tcell.EventKey:
+		e.Type = EventTypeKey
+		e.Name = ev.Name()
+		e.Key = ev.Key()
+		e.Str = ev.Str()
+		e.Mod = ev.Modifiers()
+	case *tcell.EventMouse:
+		e.Type = EventTypeMouse
+		e.X, e.Y = ev.Position()
+		e.Buttons = ev.Buttons()
+		e.Mod = ev.Modifiers()
+	case *tcell.EventPaste:
+		e.Type = EventTypePaste
+		e.Start = ev.Start()
+	case *tcell.EventResize:
+		e.Type = EventTypeResize
+		e.Width, e.Height = ev.Size()
+	case *tcell.EventFocus:
+		e.Type = EventTypeFocus
+		e.Focused = ev.Focused
+	default:
+		return e, false
+	}
+	return e, true
+}
+
+// TCell returns a new tcell.Event equivalent to the Event, or nil if the
+// Event's type is unknown or is not an input event.
+func (e Event) TCell() tcell.Event {
+	switch e.Type {
+	case EventTypeKey:
+		return tcell.NewEventKey(e.Key, e.Str, e.Mod)
+	case EventTypeMouse:
+		return tcell.NewEventMouse(e.X, e.Y, e.Buttons, e.Mod)
+	case EventTypePaste:
+		return tcell.NewEventPaste(e.Start)
+	case EventTypeResize:
+		return tcell.NewEventResize(e.Width, e.Height)
+	case EventTypeFocus:
+		return tcell.NewEventFocus(e.Focused)
+	}
+	return nil
+}
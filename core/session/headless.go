@@ -0,0 +1,66 @@
+package session
+
+import (
+	"strings"
+
+	"github.com/gdamore/tcell/v3"
+	"github.com/gdamore/tcell/v3/vt"
+)
+
+// HeadlessScreen is a tcell.Screen that draws to an in-memory terminal
+// instead of a real one, for replaying a session without a terminal, e.g. in
+// a test.
+type HeadlessScreen struct {
+	tcell.Screen
+	// text is the content of the screen when it was finalized.
+	text []string
+}
+
+// NewHeadlessScreen returns a new, initialized HeadlessScreen of the supplied
+// size.
+func NewHeadlessScreen(width, height int) (*HeadlessScreen, error) {
+	mt := vt.NewMockTerm(vt.MockOptSize{X: vt.Col(width), Y: vt.Row(height)})
+	s, err := tcell.NewTerminfoScreenFromTty(mt)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Init(); err != nil {
+		return nil, err
+	}
+	return &HeadlessScreen{Screen: s}, nil
+}
+
+// Fini finalizes the screen, keeping its content so that Text still returns
+// what was last drawn after an Application exits.
+func (s *HeadlessScreen) Fini() {
+	s.text = s.lines()
+	s.Screen.Fini()
+}
+
+// Text returns the content of the screen, one string per row with trailing
+// spaces removed.
+func (s *HeadlessScreen) Text() []string {
+	if s.text != nil {
+		return s.text
+	}
+	return s.lines()
+}
+
+// lines reads the content of the screen, one string per row.
+func (s *HeadlessScreen) lines() []string {
+	w, h := s.Size()
+	lines := make([]string, 0, h)
+	for y := range h {
+		sb := strings.Builder{}
+		for x := 0; x < w; {
+			str, _, cw := s.Get(x, y)
+			if str == "" {
+				str = " "
+			}
+			sb.WriteString(str)
+			x += max(cw, 1)
+		}
+		lines = append(lines, strings.TrimRight(sb.String(), " "))
+	}
+	return lines
+}
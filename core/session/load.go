@@ -0,0 +1,74 @@
+package session
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Load returns the Events in the session file contents read from the
+// supplied reader. Blank lines are ignored.
+func Load(r io.Reader) ([]Event, error) {
+	events := []Event{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+		e := Event{}
+		if err := json.Unmarshal(data, &e); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if e.Type != EventTypeBackground && e.TCell() == nil {
+			return nil, fmt.Errorf("line %d: unknown event type %q", line, e.Type)
+		}
+		events = append(events, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// LoadFile returns the Events in the session file at the supplied path.
+func LoadFile(path string) ([]Event, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	events, err := Load(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return events, nil
+}
+
+// Size returns the size of the screen when the session began, from its first
+// resize Event, and whether the session recorded one.
+func Size(events []Event) (width, height int, ok bool) {
+	for _, e := range events {
+		if e.Type == EventTypeResize {
+			return e.Width, e.Height, true
+		}
+	}
+	return 0, 0, false
+}
+
+// DarkBackground returns whether the terminal had a dark background when the
+// session was recorded, and whether the session recorded it.
+func DarkBackground(events []Event) (dark bool, ok bool) {
+	for _, e := range events {
+		if e.Type == EventTypeBackground {
+			return e.Dark, true
+		}
+	}
+	return false, false
+}
@@ -0,0 +1,159 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/gdamore/tcell/v3"
+
+	"github.com/jaypipes/gt/types"
+)
+
+// WithSpeed sets how fast a Player replays Events relative to the speed they
+// were recorded at, e.g. 2 for twice as fast. A speed of 0 replays Events
+// without any delay between them.
+func WithSpeed(speed float64) types.SessionPlayerWithOption {
+	return func(p types.SessionPlayer) {
+		p.SetSpeed(speed)
+	}
+}
+
+// WithStep sets whether a Player waits to be advanced before replaying each
+// Event, instead of replaying Events at their recorded times.
+func WithStep(on bool) types.SessionPlayerWithOption {
+	return func(p types.SessionPlayer) {
+		p.SetStep(on)
+	}
+}
+
+// Player replays the Events of a recorded session.
+type Player struct {
+	// events are the Events being replayed.
+	events []Event
+	// pos is the index of the next Event to replay.
+	pos int
+	// speed is how fast Events are replayed relative to the speed they were
+	// recorded at.
+	speed float64
+	// step is true if the Player waits to be advanced before each Event.
+	step bool
+}
+
+// NewPlayer returns a new Player replaying the supplied Events at the speed
+// they were recorded at.
+//
+// You can pass zero or more SessionPlayerWithOptions to change the speed or
+// step through the Events.
+func NewPlayer(
+	events []Event,
+	opts ...types.SessionPlayerWithOption,
+) *Player {
+	p := &Player{events: events, speed: 1}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// Speed returns how fast the Player replays Events relative to the speed
+// they were recorded at.
+func (p *Player) Speed() float64 {
+	return p.speed
+}
+
+// SetSpeed sets how fast the Player replays Events relative to the speed
+// they were recorded at, e.g. 2 for twice as fast. A speed of 0 replays
+// Events without any delay between them.
+func (p *Player) SetSpeed(speed float64) {
+	p.speed = speed
+}
+
+// Step returns whether the Player waits to be advanced before replaying each
+// Event.
+func (p *Player) Step() bool {
+	return p.step
+}
+
+// SetStep sets whether the Player waits to be advanced before replaying each
+// Event, instead of replaying Events at their recorded times.
+func (p *Player) SetStep(on bool) {
+	p.step = on
+}
+
+// Len returns the number of Events being replayed.
+func (p *Player) Len() int {
+	return len(p.events)
+}
+
+// Position returns the index of the next Event to replay.
+func (p *Player) Position() int {
+	return p.pos
+}
+
+// Done returns true if every Event has been replayed.
+func (p *Player) Done() bool {
+	return p.pos >= len(p.events)
+}
+
+// Reset rewinds the Player to the first Event.
+func (p *Player) Reset() {
+	p.pos = 0
+}
+
+// Next returns the next Event as a tcell.Event along with how long to wait,
+// at the Player's speed, before replaying it. It returns false once every
+// Event has been replayed.
+func (p *Player) Next() (tcell.Event, time.Duration, bool) {
+	for !p.Done() {
+		e := p.events[p.pos]
+		var delay time.Duration
+		if p.pos > 0 && p.speed > 0 && !p.step {
+			gap := e.At - p.events[p.pos-1].At
+			delay = time.Duration(float64(gap) / p.speed)
+		}
+		p.pos++
+		// Events that are not input events, e.g. the terminal's
+		// background, only describe the session and are skipped.
+		if ev := e.TCell(); ev != nil {
+			return ev, delay, true
+		}
+	}
+	return nil, 0, false
+}
+
+// Play replays the remaining Events by passing each one to the supplied post
+// function, typically one sending it to a Screen's event queue.
+//
+// When stepping through the Events, Play waits for a value on the supplied
+// advance channel before each Event. Otherwise, it waits for each Event's
+// delay. Play returns early with the context's error if the context is done.
+func (p *Player) Play(
+	ctx context.Context,
+	post func(tcell.Event),
+	advance <-chan struct{},
+) error {
+	for {
+		ev, delay, ok := p.Next()
+		if !ok {
+			return nil
+		}
+		if p.step {
+			select {
+			case <-advance:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		} else if delay > 0 {
+			t := time.NewTimer(delay)
+			select {
+			case <-t.C:
+			case <-ctx.Done():
+				t.Stop()
+				return ctx.Err()
+			}
+		}
+		post(ev)
+	}
+}
+
+var _ types.SessionPlayer = (*Player)(nil)
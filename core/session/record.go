@@ -0,0 +1,77 @@
+package session
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gdamore/tcell/v3"
+)
+
+// Recorder writes the input events received by an Application to a session
+// file, one JSON-encoded Event per line.
+type Recorder struct {
+	sync.Mutex
+	// enc encodes Events to the session file.
+	enc *json.Encoder
+	// closer closes the session file, if the Recorder opened it.
+	closer io.Closer
+	// start is when the session started.
+	start time.Time
+}
+
+// NewRecorder returns a new Recorder writing Events to the supplied writer.
+// The session starts now.
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{enc: json.NewEncoder(w), start: time.Now()}
+}
+
+// CreateRecorder returns a new Recorder writing Events to the session file at
+// the supplied path, which is created or truncated.
+func CreateRecorder(path string) (*Recorder, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	r := NewRecorder(f)
+	r.closer = f
+	return r, nil
+}
+
+// Record writes the supplied tcell.Event to the session file, if it is a kind
+// of input event that is recorded.
+func (r *Recorder) Record(ev tcell.Event) error {
+	r.Lock()
+	defer r.Unlock()
+	e, ok := FromTCell(time.Since(r.start), ev)
+	if !ok {
+		return nil
+	}
+	return r.enc.Encode(e)
+}
+
+// RecordBackground writes whether the terminal has a dark background to the
+// session file, so that a replay can select the same Theme variant.
+func (r *Recorder) RecordBackground(dark bool) error {
+	r.Lock()
+	defer r.Unlock()
+	return r.enc.Encode(Event{
+		At:   time.Since(r.start),
+		Type: EventTypeBackground,
+		Dark: dark,
+	})
+}
+
+// Close closes the session file if the Recorder opened it.
+func (r *Recorder) Close() error {
+	r.Lock()
+	defer r.Unlock()
+	if r.closer == nil {
+		return nil
+	}
+	err := r.closer.Close()
+	r.closer = nil
+	return err
+}
@@ -0,0 +1,54 @@
+package shadow
+
+import (
+	"github.com/jaypipes/gt/core/graphic"
+	"github.com/jaypipes/gt/types"
+)
+
+// New returns a new Shadow. By default, the Shadow is offset one cell to the
+// right and one line below the Box casting it and is drawn using the light
+// shade block grapheme (░).
+//
+// You can pass zero or more ShadowWithOptions to optionally set certain
+// attributes on the returned Shadow.
+func New(opts ...types.ShadowWithOption) types.Shadow {
+	s := &Shadow{
+		offset:  types.Point{X: 1, Y: 1},
+		content: string(graphic.BlockLightShade),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// WithOffset sets the types.Shadow's offset to the supplied value.
+func WithOffset(offset types.Point) types.ShadowWithOption {
+	return func(s types.Shadow) {
+		s.SetOffset(offset)
+	}
+}
+
+// WithContent sets the grapheme used to draw the types.Shadow's cells to the
+// supplied value.
+func WithContent[T string | rune](content T) types.ShadowWithOption {
+	return func(s types.Shadow) {
+		s.SetContent(string(content))
+	}
+}
+
+// WithForegroundColor sets the types.Shadow's foreground color to the supplied
+// value.
+func WithForegroundColor(color types.Color) types.ShadowWithOption {
+	return func(s types.Shadow) {
+		s.SetForegroundColor(color)
+	}
+}
+
+// WithBackgroundColor sets the types.Shadow's background color to the supplied
+// value.
+func WithBackgroundColor(color types.Color) types.ShadowWithOption {
+	return func(s types.Shadow) {
+		s.SetBackgroundColor(color)
+	}
+}
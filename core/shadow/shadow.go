@@ -0,0 +1,62 @@
+package shadow
+
+import (
+	"github.com/jaypipes/gt/types"
+)
+
+// Shadow is a drop shadow drawn outside the bottom and right edges of a Box.
+type Shadow struct {
+	// offset is the number of cells and lines the Shadow is offset to the
+	// right and below the Box casting it.
+	offset types.Point
+	// content is the grapheme used to draw the Shadow's cells.
+	content string
+	// fgColor is the foreground color of the Shadow's cells, if any.
+	fgColor types.Color
+	// bgColor is the background color of the Shadow's cells, if any.
+	bgColor types.Color
+}
+
+// Offset returns the number of cells and lines the Shadow is offset to the
+// right and below the Box casting it.
+func (s *Shadow) Offset() types.Point {
+	return s.offset
+}
+
+// SetOffset sets the number of cells and lines the Shadow is offset to the
+// right and below the Box casting it.
+func (s *Shadow) SetOffset(offset types.Point) {
+	s.offset = offset
+}
+
+// Content returns the grapheme used to draw the Shadow's cells.
+func (s *Shadow) Content() string {
+	return s.content
+}
+
+// SetContent sets the grapheme used to draw the Shadow's cells.
+func (s *Shadow) SetContent(content string) {
+	s.content = content
+}
+
+// ForegroundColor returns the foreground color of the Shadow's cells.
+func (s *Shadow) ForegroundColor() types.Color {
+	return s.fgColor
+}
+
+// SetForegroundColor sets the foreground color of the Shadow's cells.
+func (s *Shadow) SetForegroundColor(color types.Color) {
+	s.fgColor = color
+}
+
+// BackgroundColor returns the background color of the Shadow's cells.
+func (s *Shadow) BackgroundColor() types.Color {
+	return s.bgColor
+}
+
+// SetBackgroundColor sets the background color of the Shadow's cells.
+func (s *Shadow) SetBackgroundColor(color types.Color) {
+	s.bgColor = color
+}
+
+var _ types.Shadow = (*Shadow)(nil)
@@ -0,0 +1,97 @@
+package style
+
+import (
+	"github.com/jaypipes/gt/types"
+)
+
+// Inherit returns the supplied Style with each inherited Property that it
+// does not specify taken from the supplied parent Style. If the Style
+// specifies every Property the parent does, the Style itself is returned.
+func Inherit(s, parent types.Style) types.Style {
+	if parent == nil {
+		return s
+	}
+	var out *Style
+	if s == nil {
+		// Only the inherited Properties of the parent Style are taken, not
+		// the parent Style itself.
+		out = Empty()
+		s = out
+	}
+	for p := range propBits {
+		if !p.Inherited() || s.Specified(p) || !parent.Specified(p) {
+			continue
+		}
+		if out == nil {
+			out = Clone(s)
+		}
+		Copy(out, parent, p)
+	}
+	if out == nil {
+		return s
+	}
+	return out
+}
+
+// Copy sets the supplied Property of the dst Style to the value of the
+// Property in the src Style. A nil src Style copies the initial value.
+func Copy(dst, src types.Style, p types.Property) {
+	if src == nil {
+		src = Empty()
+	}
+	switch p {
+	case types.PropertyForegroundColor:
+		dst.SetForegroundColor(src.ForegroundColor())
+	case types.PropertyBackgroundColor:
+		dst.SetBackgroundColor(src.BackgroundColor())
+	case types.PropertyBold:
+		dst.SetBold(src.Bold())
+	case types.PropertyItalic:
+		dst.SetItalic(src.Italic())
+	case types.PropertyDim:
+		dst.SetDim(src.Dim())
+	case types.PropertyUnderline:
+		dst.SetUnderlineStyle(src.UnderlineStyle())
+		dst.SetUnderlineColor(src.UnderlineColor())
+	case types.PropertyStrikethrough:
+		dst.SetStrikethrough(src.Strikethrough())
+	case types.PropertyBlink:
+		dst.SetBlink(src.Blink())
+	case types.PropertyReverse:
+		dst.SetReverse(src.Reverse())
+	case types.PropertyHyperlink:
+		dst.SetHyperlink(src.Hyperlink())
+	}
+}
+
+// Value returns the value of the supplied Property in the Style, or nil if
+// the Style cannot set the Property.
+func Value(s types.Style, p types.Property) any {
+	if s == nil {
+		s = Empty()
+	}
+	switch p {
+	case types.PropertyForegroundColor:
+		return s.ForegroundColor()
+	case types.PropertyBackgroundColor:
+		return s.BackgroundColor()
+	case types.PropertyBold:
+		return s.Bold()
+	case types.PropertyItalic:
+		return s.Italic()
+	case types.PropertyDim:
+		return s.Dim()
+	case types.PropertyUnderline:
+		return s.UnderlineStyle()
+	case types.PropertyStrikethrough:
+		return s.Strikethrough()
+	case types.PropertyBlink:
+		return s.Blink()
+	case types.PropertyReverse:
+		return s.Reverse()
+	case types.PropertyHyperlink:
+		url, id := s.Hyperlink()
+		return types.Hyperlink{URL: url, ID: id}
+	}
+	return nil
+}
@@ -49,6 +49,13 @@ func WithStrikethrough() types.StyleWithOption {
 	}
 }
 
+// WithReverse enables the reverse attribute in the Style.
+func WithReverse() types.StyleWithOption {
+	return func(s types.Style) {
+		s.SetReverse(true)
+	}
+}
+
 // WithForegroundColor sets the types.Style's foreground color to the supplied
 // value.
 func WithForegroundColor(color types.Color) types.StyleWithOption {
@@ -82,3 +89,27 @@ func WithUnderlineStyle(
 		s.SetUnderlineStyle(ulStyle)
 	}
 }
+
+// WithHyperlink sets the types.Style's OSC 8 hyperlink to the supplied URL
+// and optional ID.
+func WithHyperlink(url, id string) types.StyleWithOption {
+	return func(s types.Style) {
+		s.SetHyperlink(url, id)
+	}
+}
+
+// Clone returns a new Style with the same attributes and colors as the
+// supplied Style. If the supplied Style is nil, an empty Style is returned.
+func Clone(s types.Style) *Style {
+	out := &Style{}
+	if s == nil {
+		return out
+	}
+	for p := range propBits {
+		if s.Specified(p) {
+			Copy(out, s, p)
+		}
+	}
+	out.SetUnderlineColor(s.UnderlineColor())
+	return out
+}
@@ -0,0 +1,75 @@
+package style
+
+import (
+	"strconv"
+
+	"github.com/gdamore/tcell/v3"
+	tccolor "github.com/gdamore/tcell/v3/color"
+	"github.com/lucasb-eyer/go-colorful"
+	"github.com/muesli/termenv"
+
+	"github.com/jaypipes/gt/types"
+)
+
+// Degrade returns the nearest color to the supplied color that is supported by
+// the supplied color profile. The returned color is
+// [tccolor.Default] for the monochrome profile.
+func Degrade(c types.Color, profile types.ColorProfile) tccolor.Color {
+	tc, ok := c.(tccolor.Color)
+	if !ok {
+		tc = tccolor.FromImageColor(c)
+	}
+	if !tc.Valid() {
+		return tc
+	}
+	var tp termenv.Profile
+	switch profile {
+	case types.ColorProfileMonochrome:
+		return tccolor.Default
+	case types.ColorProfileANSI256:
+		tp = termenv.ANSI256
+	case types.ColorProfileANSI:
+		tp = termenv.ANSI
+	default:
+		return tc
+	}
+	var tec termenv.Color
+	if tc.IsRGB() {
+		tec = tp.FromColor(tc)
+	} else {
+		idx := int(tc &^ tccolor.IsValid)
+		if idx > 255 {
+			// Special colors like reset have no palette equivalent.
+			return tc
+		}
+		tec = tp.Color(strconv.Itoa(idx))
+	}
+	switch tec := tec.(type) {
+	case termenv.ANSIColor:
+		return tccolor.PaletteColor(int(tec))
+	case termenv.ANSI256Color:
+		return tccolor.PaletteColor(int(tec))
+	case termenv.RGBColor:
+		rgb, err := colorful.Hex(string(tec))
+		if err != nil {
+			return tc
+		}
+		return tccolor.FromImageColor(rgb)
+	}
+	return tccolor.Default
+}
+
+// DegradeTCell returns the supplied tcell.Style with its foreground,
+// background and underline colors mapped to the nearest colors supported by
+// the supplied color profile.
+func DegradeTCell(s tcell.Style, profile types.ColorProfile) tcell.Style {
+	if profile == types.ColorProfileTrueColor {
+		return s
+	}
+	s = s.Foreground(Degrade(s.GetForeground(), profile))
+	s = s.Background(Degrade(s.GetBackground(), profile))
+	if ul := s.GetUnderlineColor(); ul.Valid() {
+		s = s.Underline(Degrade(ul, profile))
+	}
+	return s
+}
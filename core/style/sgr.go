@@ -0,0 +1,262 @@
+package style
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+	tccolor "github.com/gdamore/tcell/v3/color"
+
+	"github.com/jaypipes/gt/types"
+)
+
+// Run is a contiguous piece of text that shares a single Style.
+type Run struct {
+	// Text is the plain text of the Run, with any ANSI escape sequences
+	// removed.
+	Text string
+	// Style is the Style of the Run's text.
+	Style types.Style
+}
+
+// ParseSGR parses the ANSI SGR (Select Graphic Rendition) escape sequences in
+// the supplied text and returns the text split into a slice of styled Runs.
+//
+// The supplied base Style, which may be nil, is the starting Style of the
+// text and the Style that an SGR reset (e.g. `ESC[0m`) returns to. Basic
+// 16-color, 256-color and truecolor (24-bit) color codes are supported for the
+// foreground, background and underline colors. OSC 8 hyperlinks set the
+// hyperlink of the Style of the text they enclose, and ending one returns to
+// the hyperlink of the base Style. Any other escape sequences, along with any
+// control characters other than newlines and tabs, are removed from the text.
+func ParseSGR(text string, base types.Style) []Run {
+	runs := []Run{}
+	cur := Clone(base)
+	sb := &strings.Builder{}
+
+	p := ansi.GetParser()
+	defer ansi.PutParser(p)
+
+	var state byte
+	for len(text) > 0 {
+		seq, width, n, newState := ansi.DecodeSequence(text, state, p)
+		state = newState
+		text = text[n:]
+		if width > 0 || seq == "\n" || seq == "\t" {
+			sb.WriteString(seq)
+			continue
+		}
+		url, id, link := parseHyperlink(seq, p)
+		if !link && !isSGR(seq, p) {
+			continue
+		}
+		if sb.Len() > 0 {
+			runs = append(runs, Run{Text: sb.String(), Style: cur})
+			sb.Reset()
+		}
+		cur = Clone(cur)
+		if link {
+			// Ending a hyperlink returns to the hyperlink of the base Style,
+			// if any.
+			if url == "" && base != nil {
+				url, id = base.Hyperlink()
+			}
+			cur.SetHyperlink(url, id)
+			continue
+		}
+		applySGR(cur, base, p.Params())
+	}
+	if sb.Len() > 0 {
+		runs = append(runs, Run{Text: sb.String(), Style: cur})
+	}
+	return runs
+}
+
+// Link returns the supplied text wrapped in the OSC 8 escape sequences that
+// make it a hyperlink to the supplied URL with the supplied optional ID. The
+// returned text can be used as the text content of an Element that honors
+// ANSI escape sequences or passed to [ParseSGR].
+func Link(text, url, id string) string {
+	if id == "" {
+		return ansi.SetHyperlink(url) + text + ansi.ResetHyperlink()
+	}
+	return ansi.SetHyperlink(url, "id="+id) + text + ansi.ResetHyperlink()
+}
+
+// StripANSI returns the supplied text with all ANSI escape sequences removed.
+func StripANSI(text string) string {
+	return ansi.Strip(text)
+}
+
+// isSGR returns true if the supplied decoded sequence is a CSI SGR sequence.
+func isSGR(seq string, p *ansi.Parser) bool {
+	if !ansi.HasCsiPrefix(seq) {
+		return false
+	}
+	cmd := ansi.Cmd(p.Command())
+	return cmd.Final() == 'm' && cmd.Prefix() == 0 && cmd.Intermediate() == 0
+}
+
+// parseHyperlink returns the URL and ID of the supplied decoded sequence and
+// true if it is an OSC 8 hyperlink sequence. The URL is empty if the sequence
+// ends a hyperlink.
+func parseHyperlink(seq string, p *ansi.Parser) (string, string, bool) {
+	if !ansi.HasOscPrefix(seq) || p.Command() != 8 {
+		return "", "", false
+	}
+	// The data is "8;params;url", where params is a colon-separated list of
+	// key=value pairs. The URL itself may contain semicolons.
+	parts := strings.SplitN(string(p.Data()), ";", 3)
+	if len(parts) < 3 {
+		return "", "", true
+	}
+	id := ""
+	for _, param := range strings.Split(parts[1], ":") {
+		if v, ok := strings.CutPrefix(param, "id="); ok {
+			id = v
+		}
+	}
+	return parts[2], id, true
+}
+
+// applySGR modifies the supplied Style according to the supplied SGR
+// parameters.
+func applySGR(s *Style, base types.Style, params ansi.Params) {
+	if len(params) == 0 {
+		s.reset(base)
+		return
+	}
+	for i := 0; i < len(params); i++ {
+		code := params[i].Param(0)
+		switch {
+		case code == 0:
+			s.reset(base)
+		case code == 1:
+			s.SetBold(true)
+		case code == 2:
+			s.SetDim(true)
+		case code == 3:
+			s.SetItalic(true)
+		case code == 4:
+			ul := types.UnderlineStyleSolid
+			// ESC[4:Nm selects an underline style (double, curly, etc)
+			if params[i].HasMore() && i+1 < len(params) {
+				i++
+				ul = types.UnderlineStyle(params[i].Param(1))
+			}
+			s.SetUnderlineStyle(ul)
+		case code == 5 || code == 6:
+			s.SetBlink(true)
+		case code == 7:
+			s.SetReverse(true)
+		case code == 9:
+			s.SetStrikethrough(true)
+		case code == 21:
+			s.SetUnderlineStyle(types.UnderlineStyleDouble)
+		case code == 22:
+			s.SetBold(false)
+			s.SetDim(false)
+		case code == 23:
+			s.SetItalic(false)
+		case code == 24:
+			s.SetUnderlineStyle(types.UnderlineStyleNone)
+		case code == 25:
+			s.SetBlink(false)
+		case code == 27:
+			s.SetReverse(false)
+		case code == 29:
+			s.SetStrikethrough(false)
+		case code >= 30 && code <= 37:
+			s.SetForegroundColor(tccolor.PaletteColor(code - 30))
+		case code == 38:
+			c, n := readSGRColor(params[i+1:])
+			if c != nil {
+				s.SetForegroundColor(c)
+			}
+			i += n
+		case code == 39:
+			s.SetForegroundColor(baseColor(base, types.Style.ForegroundColor))
+		case code >= 40 && code <= 47:
+			s.SetBackgroundColor(tccolor.PaletteColor(code - 40))
+		case code == 48:
+			c, n := readSGRColor(params[i+1:])
+			if c != nil {
+				s.SetBackgroundColor(c)
+			}
+			i += n
+		case code == 49:
+			s.SetBackgroundColor(baseColor(base, types.Style.BackgroundColor))
+		case code == 58:
+			c, n := readSGRColor(params[i+1:])
+			if c != nil {
+				s.SetUnderlineColor(c)
+			}
+			i += n
+		case code == 59:
+			s.SetUnderlineColor(baseColor(base, types.Style.UnderlineColor))
+		case code >= 90 && code <= 97:
+			s.SetForegroundColor(tccolor.PaletteColor(code - 90 + 8))
+		case code >= 100 && code <= 107:
+			s.SetBackgroundColor(tccolor.PaletteColor(code - 100 + 8))
+		}
+	}
+}
+
+// readSGRColor reads an extended color from the parameters following a 38, 48
+// or 58 SGR code. Both the `5;N` (256-color) and `2;R;G;B` (truecolor) forms
+// are supported, separated by either semicolons or colons. It returns the
+// color, which is nil if the parameters could not be read, and the number of
+// parameters consumed.
+func readSGRColor(params ansi.Params) (types.Color, int) {
+	if len(params) == 0 {
+		return nil, 0
+	}
+	switch params[0].Param(0) {
+	case 5:
+		if len(params) < 2 {
+			return nil, len(params)
+		}
+		idx := params[1].Param(0)
+		if idx < 0 || idx > 255 {
+			return nil, 2
+		}
+		return tccolor.PaletteColor(idx), 2
+	case 2:
+		// The colon-separated form may include a color space ID before the
+		// red, green and blue components, e.g. `38:2::R:G:B`.
+		vals := params[1:]
+		if params[0].HasMore() && len(vals) >= 4 && vals[2].HasMore() {
+			vals = vals[1:]
+		}
+		if len(vals) < 3 {
+			return nil, len(params)
+		}
+		consumed := len(params) - len(vals) + 3
+		return tccolor.NewRGBColor(
+			int32(vals[0].Param(0)),
+			int32(vals[1].Param(0)),
+			int32(vals[2].Param(0)),
+		), consumed
+	}
+	return nil, 1
+}
+
+// baseColor returns the color from the supplied base Style using the supplied
+// accessor, or nil if there is no base Style.
+func baseColor(
+	base types.Style,
+	accessor func(types.Style) types.Color,
+) types.Color {
+	if base == nil {
+		return nil
+	}
+	return accessor(base)
+}
+
+// reset resets the Style's attributes and colors to those of the supplied
+// base Style. An SGR reset does not end a hyperlink, so the Style's
+// hyperlink is kept.
+func (s *Style) reset(base types.Style) {
+	url, id := s.Hyperlink()
+	*s = *Clone(base)
+	s.SetHyperlink(url, id)
+}
@@ -19,6 +19,36 @@ const (
 	attrStrikethrough       = 1 << 5
 )
 
+// props is a bitmap of Properties.
+type props uint16
+
+const (
+	propBold props = 1 << iota
+	propItalic
+	propDim
+	propUnderline
+	propStrikethrough
+	propBlink
+	propReverse
+	propForegroundColor
+	propBackgroundColor
+	propHyperlink
+)
+
+// propBits maps the Properties that a Style can set to their bit in props.
+var propBits = map[types.Property]props{
+	types.PropertyBold:            propBold,
+	types.PropertyItalic:          propItalic,
+	types.PropertyDim:             propDim,
+	types.PropertyUnderline:       propUnderline,
+	types.PropertyStrikethrough:   propStrikethrough,
+	types.PropertyBlink:           propBlink,
+	types.PropertyReverse:         propReverse,
+	types.PropertyForegroundColor: propForegroundColor,
+	types.PropertyBackgroundColor: propBackgroundColor,
+	types.PropertyHyperlink:       propHyperlink,
+}
+
 // Style represents the style of a [Cell] being displayed in a [Screen].
 type Style struct {
 	// attrs is a bitmap storing style attributes
@@ -31,6 +61,12 @@ type Style struct {
 	ulStyle types.UnderlineStyle
 	// ulColor is the style of the underline, if any
 	ulColor types.Color
+	// linkURL is the URL of the Style's hyperlink, if any
+	linkURL string
+	// linkID is the ID of the Style's hyperlink, if any
+	linkID string
+	// specified is a bitmap of the Properties explicitly set on the Style
+	specified props
 }
 
 // String returns a short string description of the Style.
@@ -55,6 +91,9 @@ func (s *Style) String() string {
 	if s.Blink() {
 		attrsOn = append(attrsOn, "blink")
 	}
+	if s.Reverse() {
+		attrsOn = append(attrsOn, "reverse")
+	}
 	if s.Underline() {
 		attrsOn = append(attrsOn, "underline")
 	}
@@ -70,6 +109,9 @@ func (s *Style) String() string {
 	if s.bgColor != nil {
 		parts = append(parts, fmt.Sprintf("bg:%s", colorRGBHex(s.bgColor)))
 	}
+	if s.linkURL != "" {
+		parts = append(parts, fmt.Sprintf("link:%s", s.linkURL))
+	}
 	return strings.Join(parts, " ")
 }
 
@@ -78,7 +120,15 @@ func (s *Style) Unstyled() bool {
 	return s.attrs == attrNone &&
 		s.ulStyle == types.UnderlineStyleNone &&
 		s.fgColor == nil &&
-		s.bgColor == nil
+		s.bgColor == nil &&
+		s.linkURL == ""
+}
+
+// Specified returns true if the Style explicitly sets the supplied Property,
+// even to its initial value.
+func (s *Style) Specified(p types.Property) bool {
+	bit, ok := propBits[p]
+	return ok && s.specified&bit != 0
 }
 
 // Bold returns true if the Style is bolded.
@@ -88,6 +138,7 @@ func (s *Style) Bold() bool {
 
 // SetBold sets the Style's bold attribute.
 func (s *Style) SetBold(on bool) {
+	s.specified |= propBold
 	if on {
 		s.attrs |= attrBold
 	} else {
@@ -108,6 +159,7 @@ func (s *Style) Italic() bool {
 
 // SetItalic sets the Style's italic attribute.
 func (s *Style) SetItalic(on bool) {
+	s.specified |= propItalic
 	if on {
 		s.attrs |= attrItalic
 	} else {
@@ -128,6 +180,7 @@ func (s *Style) Dim() bool {
 
 // SetDim sets the Style's dim attribute.
 func (s *Style) SetDim(on bool) {
+	s.specified |= propDim
 	if on {
 		s.attrs |= attrDim
 	} else {
@@ -148,6 +201,7 @@ func (s *Style) Strikethrough() bool {
 
 // SetStrikethrough sets the Style's strikethrough attribute.
 func (s *Style) SetStrikethrough(on bool) {
+	s.specified |= propStrikethrough
 	if on {
 		s.attrs |= attrStrikethrough
 	} else {
@@ -169,6 +223,7 @@ func (s *Style) Blink() bool {
 
 // SetBlink sets the Style's blink attribute.
 func (s *Style) SetBlink(on bool) {
+	s.specified |= propBlink
 	if on {
 		s.attrs |= attrBlink
 	} else {
@@ -182,6 +237,28 @@ func (s *Style) WithBlink(on bool) *Style {
 	return s
 }
 
+// Reverse returns true if the Style has its foreground and background colors
+// reversed.
+func (s *Style) Reverse() bool {
+	return s.attrs&attrReverse != 0
+}
+
+// SetReverse sets the Style's reverse attribute.
+func (s *Style) SetReverse(on bool) {
+	s.specified |= propReverse
+	if on {
+		s.attrs |= attrReverse
+	} else {
+		s.attrs &^= attrReverse
+	}
+}
+
+// WithReverse sets the Style's reverse attribute and returns the Style
+func (s *Style) WithReverse(on bool) *Style {
+	s.SetReverse(on)
+	return s
+}
+
 // Underline returns true if the Style is underlined.
 func (s *Style) Underline() bool {
 	return s.ulStyle != types.UnderlineStyleNone
@@ -194,6 +271,7 @@ func (s *Style) UnderlineStyle() types.UnderlineStyle {
 
 // SetUnderlineStyle sets the Style's underline style.
 func (s *Style) SetUnderlineStyle(us types.UnderlineStyle) {
+	s.specified |= propUnderline
 	s.ulStyle = us
 }
 
@@ -226,6 +304,7 @@ func (s *Style) ForegroundColor() types.Color {
 
 // SetForegroundColor sets the Style's foreground color.
 func (s *Style) SetForegroundColor(color types.Color) {
+	s.specified |= propForegroundColor
 	s.fgColor = color
 }
 
@@ -242,6 +321,7 @@ func (s *Style) BackgroundColor() types.Color {
 
 // SetBackgroundColor sets the Style's background color.
 func (s *Style) SetBackgroundColor(color types.Color) {
+	s.specified |= propBackgroundColor
 	s.bgColor = color
 }
 
@@ -251,6 +331,28 @@ func (s *Style) WithBackgroundColor(color types.Color) *Style {
 	return s
 }
 
+// Hyperlink returns the URL and ID of the Style's OSC 8 hyperlink, if any.
+func (s *Style) Hyperlink() (string, string) {
+	return s.linkURL, s.linkID
+}
+
+// SetHyperlink sets the URL and optional ID of the Style's OSC 8 hyperlink.
+// An empty URL removes the hyperlink.
+func (s *Style) SetHyperlink(url, id string) {
+	s.specified |= propHyperlink
+	s.linkURL = url
+	s.linkID = id
+	if url == "" {
+		s.linkID = ""
+	}
+}
+
+// WithHyperlink sets the Style's OSC 8 hyperlink and returns the Style.
+func (s *Style) WithHyperlink(url, id string) *Style {
+	s.SetHyperlink(url, id)
+	return s
+}
+
 // colorRGBHex returns the supplied color's 6-character (RRGGBB) hex string.
 func colorRGBHex(c types.Color) string {
 	cr, cg, cb, _ := c.RGBA()
@@ -28,20 +28,41 @@ func TCell(s types.Style) tcell.Style {
 	if s.Blink() {
 		out = out.Blink(true)
 	}
+	if s.Reverse() {
+		out = out.Reverse(true)
+	}
 	if s.Underline() {
 		params := []any{
 			tcell.UnderlineStyle(s.UnderlineStyle()),
-			s.UnderlineColor(),
+		}
+		ul := s.UnderlineColor()
+		if ul != nil {
+			params = append(params, tcellColor(ul))
 		}
 		out = out.Underline(params...)
 	}
 	fg := s.ForegroundColor()
 	if fg != nil {
-		out = out.Foreground(tccolor.FromImageColor(fg))
+		out = out.Foreground(tcellColor(fg))
 	}
 	bg := s.BackgroundColor()
 	if bg != nil {
-		out = out.Background(tccolor.FromImageColor(bg))
+		out = out.Background(tcellColor(bg))
+	}
+	// Terminals that do not support OSC 8 hyperlinks ignore them and display
+	// the text as usual.
+	if url, id := s.Hyperlink(); url != "" {
+		out = out.Url(url)
+		if id != "" {
+			out = out.UrlId(id)
+		}
 	}
 	return out
 }
+
+// tcellColor returns the tcell color for the supplied color. Colors are
+// mapped to the nearest color supported by the terminal's color profile when
+// they are drawn, not here; see [DegradeTCell].
+func tcellColor(c types.Color) tccolor.Color {
+	return Degrade(c, types.ColorProfileTrueColor)
+}
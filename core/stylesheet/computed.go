@@ -0,0 +1,102 @@
+package stylesheet
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jaypipes/gt/core/style"
+	"github.com/jaypipes/gt/types"
+)
+
+// properties lists every Property, in the order they are reported by
+// Computed.String.
+var properties = []types.Property{
+	types.PropertyForegroundColor,
+	types.PropertyBackgroundColor,
+	types.PropertyBold,
+	types.PropertyItalic,
+	types.PropertyDim,
+	types.PropertyUnderline,
+	types.PropertyStrikethrough,
+	types.PropertyBlink,
+	types.PropertyReverse,
+	types.PropertyHyperlink,
+	types.PropertyBorder,
+	types.PropertyPadding,
+	types.PropertyWidth,
+	types.PropertyHeight,
+	types.PropertyAlignment,
+	types.PropertyDisplay,
+}
+
+// Computed contains the resolved value of each Property of an Element.
+type Computed map[types.Property]any
+
+// String returns a description of the Computed values, one Property per
+// line.
+func (c Computed) String() string {
+	var b strings.Builder
+	for _, p := range properties {
+		v, ok := c[p]
+		if !ok {
+			continue
+		}
+		fmt.Fprintf(&b, "%s: %s\n", p, formatValue(v))
+	}
+	return b.String()
+}
+
+// ComputedStyle returns the resolved value of each Property of the supplied
+// Element, after applying its Theme, Motif, state, Stylesheets and any
+// values inherited from its ancestors. It is intended for debugging.
+func ComputedStyle(el types.Element) Computed {
+	c := Computed{}
+	s := el.Style()
+	for _, p := range properties {
+		if p.Inherited() {
+			c[p] = style.Value(s, p)
+		}
+	}
+	c[types.PropertyBorder] = el.Border()
+	c[types.PropertyPadding] = el.Padding()
+	c[types.PropertyWidth] = el.WidthConstraint()
+	c[types.PropertyHeight] = el.HeightConstraint()
+	c[types.PropertyAlignment] = el.Alignment()
+	c[types.PropertyDisplay] = el.Display()
+	return c
+}
+
+// formatValue returns a short string description of a Property value.
+func formatValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "none"
+	case types.Color:
+		if v == nil {
+			return "none"
+		}
+		r, g, b, _ := v.RGBA()
+		return fmt.Sprintf("#%02x%02x%02x", r>>8, g>>8, b>>8)
+	case types.Border:
+		if v == nil || v.Empty() {
+			return "none"
+		}
+		cells := []types.Cell{
+			v.TL(), v.T(), v.TR(), v.L(), v.R(), v.BL(), v.B(), v.BR(),
+		}
+		var b strings.Builder
+		for _, c := range cells {
+			if c == nil || c.Content() == "" {
+				b.WriteString(" ")
+				continue
+			}
+			b.WriteString(c.Content())
+		}
+		return fmt.Sprintf("%q", b.String())
+	case types.DimensionConstraint:
+		if v == nil {
+			return "none"
+		}
+	}
+	return fmt.Sprintf("%v", v)
+}
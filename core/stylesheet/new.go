@@ -0,0 +1,151 @@
+package stylesheet
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jaypipes/gt/types"
+)
+
+// New returns a new instance of a Stylesheet.
+//
+// You can pass zero or more StylesheetWithOptions to optionally set certain
+// attributes on the returned Stylesheet.
+func New(opts ...types.StylesheetWithOption) *Stylesheet {
+	s := &Stylesheet{}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// WithRule appends a Rule with the supplied selector and Declarations to the
+// Stylesheet. The selector may be a comma-separated list of selectors, e.g.
+// "gt.button:focus, gt.textarea:focus".
+//
+// WithRule panics if the selector is invalid. Use [NewRule] to check the
+// selector for errors.
+func WithRule(
+	selector string,
+	decls ...types.Declaration,
+) types.StylesheetWithOption {
+	r, err := NewRule(selector, decls...)
+	if err != nil {
+		panic(err)
+	}
+	return func(s types.Stylesheet) {
+		s.AddRule(r)
+	}
+}
+
+// NewRule returns a new Rule with the supplied selector and Declarations. The
+// selector may be a comma-separated list of selectors.
+func NewRule(selector string, decls ...types.Declaration) (*Rule, error) {
+	r := &Rule{declarations: decls}
+	for _, text := range strings.Split(selector, ",") {
+		s, err := ParseSelector(text)
+		if err != nil {
+			return nil, err
+		}
+		r.selectors = append(r.selectors, s)
+	}
+	if len(r.selectors) == 0 {
+		return nil, fmt.Errorf("rule has no selectors")
+	}
+	return r, nil
+}
+
+// ForegroundColor declares the foreground color of the Element's Style.
+func ForegroundColor(c types.Color) types.Declaration {
+	return types.Declaration{Property: types.PropertyForegroundColor, Value: c}
+}
+
+// BackgroundColor declares the background color of the Element's Style.
+func BackgroundColor(c types.Color) types.Declaration {
+	return types.Declaration{Property: types.PropertyBackgroundColor, Value: c}
+}
+
+// Bold declares the bold attribute of the Element's Style.
+func Bold(on bool) types.Declaration {
+	return types.Declaration{Property: types.PropertyBold, Value: on}
+}
+
+// Italic declares the italic attribute of the Element's Style.
+func Italic(on bool) types.Declaration {
+	return types.Declaration{Property: types.PropertyItalic, Value: on}
+}
+
+// Dim declares the dim attribute of the Element's Style.
+func Dim(on bool) types.Declaration {
+	return types.Declaration{Property: types.PropertyDim, Value: on}
+}
+
+// Underline declares the underline style of the Element's Style.
+func Underline(ul types.UnderlineStyle) types.Declaration {
+	return types.Declaration{Property: types.PropertyUnderline, Value: ul}
+}
+
+// Strikethrough declares the strikethrough attribute of the Element's Style.
+func Strikethrough(on bool) types.Declaration {
+	return types.Declaration{Property: types.PropertyStrikethrough, Value: on}
+}
+
+// Blink declares the blink attribute of the Element's Style.
+func Blink(on bool) types.Declaration {
+	return types.Declaration{Property: types.PropertyBlink, Value: on}
+}
+
+// Reverse declares the reverse attribute of the Element's Style.
+func Reverse(on bool) types.Declaration {
+	return types.Declaration{Property: types.PropertyReverse, Value: on}
+}
+
+// Hyperlink declares the OSC 8 hyperlink of the Element's Style.
+func Hyperlink(url, id string) types.Declaration {
+	return types.Declaration{
+		Property: types.PropertyHyperlink,
+		Value:    types.Hyperlink{URL: url, ID: id},
+	}
+}
+
+// Border declares the Element's Border.
+func Border(b types.Border) types.Declaration {
+	return types.Declaration{Property: types.PropertyBorder, Value: b}
+}
+
+// Padding declares the Element's Padding.
+func Padding(p types.Padding) types.Declaration {
+	return types.Declaration{Property: types.PropertyPadding, Value: p}
+}
+
+// Width declares the Element's width.
+func Width(c types.DimensionConstraint) types.Declaration {
+	return types.Declaration{Property: types.PropertyWidth, Value: c}
+}
+
+// Height declares the Element's height.
+func Height(c types.DimensionConstraint) types.Declaration {
+	return types.Declaration{Property: types.PropertyHeight, Value: c}
+}
+
+// Alignment declares the Element's Alignment.
+func Alignment(a types.Alignment) types.Declaration {
+	return types.Declaration{Property: types.PropertyAlignment, Value: a}
+}
+
+// Display declares the Element's Display.
+func Display(d types.Display) types.Declaration {
+	return types.Declaration{Property: types.PropertyDisplay, Value: d}
+}
+
+// Inherit declares that the Element takes the value of the supplied Property
+// from its parent Element, even if the Property is not normally inherited.
+func Inherit(p types.Property) types.Declaration {
+	return types.Declaration{Property: p, Value: types.Inherit}
+}
+
+// Initial declares that the supplied Property of the Element has its initial
+// value, ignoring the Element's own value and any inherited value.
+func Initial(p types.Property) types.Declaration {
+	return types.Declaration{Property: p, Value: types.Initial}
+}
@@ -0,0 +1,44 @@
+package stylesheet
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jaypipes/gt/types"
+)
+
+// Rule is a set of Declarations applying to the Elements matched by any of a
+// set of Selectors.
+type Rule struct {
+	// selectors are the Rule's Selectors.
+	selectors []types.Selector
+	// declarations are the Rule's Declarations.
+	declarations []types.Declaration
+}
+
+// Selectors returns the Rule's Selectors.
+func (r *Rule) Selectors() []types.Selector {
+	return r.selectors
+}
+
+// Declarations returns the Rule's Declarations.
+func (r *Rule) Declarations() []types.Declaration {
+	return r.declarations
+}
+
+// String returns a CSS-like representation of the Rule.
+func (r *Rule) String() string {
+	sels := make([]string, len(r.selectors))
+	for x, s := range r.selectors {
+		sels[x] = s.String()
+	}
+	decls := make([]string, len(r.declarations))
+	for x, d := range r.declarations {
+		decls[x] = fmt.Sprintf("%s: %v;", d.Property, d.Value)
+	}
+	return fmt.Sprintf(
+		"%s { %s }", strings.Join(sels, ", "), strings.Join(decls, " "),
+	)
+}
+
+var _ types.Rule = (*Rule)(nil)
@@ -0,0 +1,246 @@
+package stylesheet
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jaypipes/gt/types"
+)
+
+// combinator describes the relationship between two compound selectors.
+type combinator uint8
+
+const (
+	// combinatorDescendant matches when the left compound selector matches
+	// any ancestor, e.g. `#sidebar gt.span`.
+	combinatorDescendant combinator = iota
+	// combinatorChild matches when the left compound selector matches the
+	// parent, e.g. `#sidebar > gt.span`.
+	combinatorChild
+)
+
+// pseudoClass is a state that an Element can be in.
+type pseudoClass string
+
+const (
+	pseudoClassFocus    pseudoClass = "focus"
+	pseudoClassHover    pseudoClass = "hover"
+	pseudoClassDisabled pseudoClass = "disabled"
+	pseudoClassActive   pseudoClass = "active"
+	pseudoClassSelected pseudoClass = "selected"
+	pseudoClassChecked  pseudoClass = "checked"
+	pseudoClassInvalid  pseudoClass = "invalid"
+)
+
+// compound matches a single Element by its class, ID and state.
+type compound struct {
+	// class is the Element class to match, e.g. "gt.button". Empty or "*"
+	// matches any class.
+	class string
+	// id is the Element ID to match. Empty matches any ID.
+	id string
+	// states are the pseudo-classes that the Element must be in.
+	states []pseudoClass
+}
+
+// matches returns true if the compound selector matches the supplied Element.
+func (c compound) matches(el types.Element) bool {
+	if c.class != "" && c.class != "*" && c.class != el.Class() {
+		return false
+	}
+	if c.id != "" && c.id != el.ID() {
+		return false
+	}
+	for _, st := range c.states {
+		switch st {
+		case pseudoClassFocus:
+			if !el.HasFocus() {
+				return false
+			}
+		case pseudoClassHover:
+			if !el.Hovered() {
+				return false
+			}
+		case pseudoClassDisabled:
+			if !el.Disabled() {
+				return false
+			}
+		case pseudoClassActive:
+			if !el.Pressed() {
+				return false
+			}
+		case pseudoClassSelected:
+			if !el.Selected() {
+				return false
+			}
+		case pseudoClassChecked:
+			if !el.Checked() {
+				return false
+			}
+		case pseudoClassInvalid:
+			if !el.Invalid() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Selector matches Elements by class (`gt.button`), ID (`#save`), state
+// pseudo-class (`:focus`, `:hover`, `:disabled`, `:active`, `:selected`,
+// `:checked`, `:invalid`) and ancestry
+// (`#sidebar gt.span` or `#sidebar > gt.span`).
+type Selector struct {
+	// text is the Selector's source text.
+	text string
+	// parts are the compound selectors, outermost ancestor first.
+	parts []compound
+	// combinators are the relationships between consecutive parts.
+	combinators []combinator
+}
+
+// ParseSelector returns the Selector described by the supplied text.
+func ParseSelector(text string) (*Selector, error) {
+	s := &Selector{text: strings.TrimSpace(text)}
+	rest := s.text
+	if rest == "" {
+		return nil, fmt.Errorf("empty selector")
+	}
+	comb := combinatorDescendant
+	for rest != "" {
+		c, n, err := parseCompound(rest)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector %q: %w", text, err)
+		}
+		if len(s.parts) > 0 {
+			s.combinators = append(s.combinators, comb)
+		}
+		s.parts = append(s.parts, c)
+		rest = rest[n:]
+		trimmed := strings.TrimLeft(rest, " \t\n")
+		comb = combinatorDescendant
+		if strings.HasPrefix(trimmed, ">") {
+			comb = combinatorChild
+			trimmed = strings.TrimLeft(trimmed[1:], " \t\n")
+			if trimmed == "" {
+				return nil, fmt.Errorf(
+					"invalid selector %q: missing selector after '>'", text,
+				)
+			}
+		} else if trimmed == rest && trimmed != "" {
+			return nil, fmt.Errorf(
+				"invalid selector %q: unexpected %q", text, trimmed[:1],
+			)
+		}
+		rest = trimmed
+	}
+	return s, nil
+}
+
+// parseCompound parses the compound selector at the start of the supplied
+// text, returning it along with the number of bytes consumed.
+func parseCompound(text string) (compound, int, error) {
+	c := compound{}
+	n := scanName(text)
+	if n == 0 && strings.HasPrefix(text, "*") {
+		n = 1
+	}
+	c.class = text[:n]
+	for n < len(text) {
+		switch text[n] {
+		case '#':
+			l := scanName(text[n+1:])
+			if l == 0 {
+				return c, 0, fmt.Errorf("missing ID after '#'")
+			}
+			c.id = text[n+1 : n+1+l]
+			n += l + 1
+		case ':':
+			l := scanName(text[n+1:])
+			st := pseudoClass(text[n+1 : n+1+l])
+			switch st {
+			case pseudoClassFocus, pseudoClassHover, pseudoClassDisabled,
+				pseudoClassActive, pseudoClassSelected, pseudoClassChecked,
+				pseudoClassInvalid:
+			default:
+				return c, 0, fmt.Errorf("unknown pseudo-class %q", st)
+			}
+			c.states = append(c.states, st)
+			n += l + 1
+		default:
+			if n == 0 {
+				return c, 0, fmt.Errorf("unexpected %q", text[:1])
+			}
+			return c, n, nil
+		}
+	}
+	return c, n, nil
+}
+
+// scanName returns the length of the class or ID name at the start of the
+// supplied text.
+func scanName(text string) int {
+	for x, r := range text {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9',
+			r == '.', r == '-', r == '_':
+		default:
+			return x
+		}
+	}
+	return len(text)
+}
+
+// String returns the Selector's source text.
+func (s *Selector) String() string {
+	return s.text
+}
+
+// Specificity returns the Selector's Specificity.
+func (s *Selector) Specificity() types.Specificity {
+	spec := types.Specificity{}
+	for _, c := range s.parts {
+		if c.id != "" {
+			spec[0]++
+		}
+		spec[1] += len(c.states)
+		if c.class != "" && c.class != "*" {
+			spec[2]++
+		}
+	}
+	return spec
+}
+
+// Matches returns true if the Selector matches the supplied Element.
+func (s *Selector) Matches(el types.Element) bool {
+	return s.matchAt(len(s.parts)-1, el)
+}
+
+// matchAt returns true if the Selector's parts up to and including the
+// supplied index match the supplied Element and its ancestors.
+func (s *Selector) matchAt(idx int, el types.Element) bool {
+	if !s.parts[idx].matches(el) {
+		return false
+	}
+	if idx == 0 {
+		return true
+	}
+	parent := parentElement(el)
+	if s.combinators[idx-1] == combinatorChild {
+		return parent != nil && s.matchAt(idx-1, parent)
+	}
+	for ; parent != nil; parent = parentElement(parent) {
+		if s.matchAt(idx-1, parent) {
+			return true
+		}
+	}
+	return false
+}
+
+// parentElement returns the supplied Element's parent Element, or nil.
+func parentElement(el types.Element) types.Element {
+	parent, _ := el.Parent().(types.Element)
+	return parent
+}
+
+var _ types.Selector = (*Selector)(nil)
@@ -0,0 +1,98 @@
+package stylesheet
+
+import (
+	"sort"
+
+	"github.com/jaypipes/gt/types"
+)
+
+// Stylesheet is an ordered collection of Rules.
+type Stylesheet struct {
+	// rules are the Stylesheet's Rules, in order.
+	rules []types.Rule
+}
+
+// Rules returns the Stylesheet's Rules, in order.
+func (s *Stylesheet) Rules() []types.Rule {
+	return s.rules
+}
+
+// AddRule appends a Rule to the Stylesheet.
+func (s *Stylesheet) AddRule(r types.Rule) {
+	s.rules = append(s.rules, r)
+}
+
+var _ types.Stylesheet = (*Stylesheet)(nil)
+
+// matched is a Declaration from a Rule that matched an Element.
+type matched struct {
+	decl        types.Declaration
+	specificity types.Specificity
+	order       int
+}
+
+// Cascade returns the value of each Property declared for the supplied
+// Element by the Rules of the supplied Stylesheets.
+//
+// When more than one matching Rule declares the same Property, the
+// Declaration from the Rule with the highest Specificity wins. Rules with
+// equal Specificity are decided by order: later Stylesheets win over earlier
+// ones and later Rules win over earlier ones.
+func Cascade(
+	el types.Element,
+	sheets ...types.Stylesheet,
+) map[types.Property]any {
+	matches := []matched{}
+	order := 0
+	for _, sheet := range sheets {
+		if sheet == nil {
+			continue
+		}
+		for _, r := range sheet.Rules() {
+			order++
+			spec, ok := ruleSpecificity(r, el)
+			if !ok {
+				continue
+			}
+			for _, d := range r.Declarations() {
+				matches = append(matches, matched{d, spec, order})
+			}
+		}
+	}
+	if len(matches) == 0 {
+		return nil
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.specificity != b.specificity {
+			return a.specificity.Less(b.specificity)
+		}
+		return a.order < b.order
+	})
+	props := make(map[types.Property]any, len(matches))
+	for _, m := range matches {
+		props[m.decl.Property] = m.decl.Value
+	}
+	return props
+}
+
+// ruleSpecificity returns the highest Specificity of the supplied Rule's
+// Selectors that match the supplied Element, and whether any matched.
+func ruleSpecificity(
+	r types.Rule,
+	el types.Element,
+) (types.Specificity, bool) {
+	var best types.Specificity
+	found := false
+	for _, s := range r.Selectors() {
+		if !s.Matches(el) {
+			continue
+		}
+		spec := s.Specificity()
+		if !found || best.Less(spec) {
+			best = spec
+		}
+		found = true
+	}
+	return best, found
+}
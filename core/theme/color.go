@@ -0,0 +1,69 @@
+package theme
+
+import (
+	"fmt"
+	"image/color"
+	"strconv"
+	"strings"
+
+	tccolor "github.com/gdamore/tcell/v3/color"
+	"github.com/lucasb-eyer/go-colorful"
+
+	"github.com/jaypipes/gt/types"
+)
+
+// parseColor returns the color described by the supplied string, looking up
+// names in the supplied palette first.
+func parseColor(s string, palette map[string]string) (types.Color, error) {
+	if ref, ok := palette[s]; ok {
+		// palette entries may not refer to other palette entries.
+		return parseColor(ref, nil)
+	}
+	lc := strings.ToLower(strings.TrimSpace(s))
+	switch {
+	case lc == "transparent":
+		return color.Transparent, nil
+	case lc == "default":
+		return tccolor.Default, nil
+	case strings.HasPrefix(lc, "#"):
+		c, err := colorful.Hex(lc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex color %q", s)
+		}
+		return c, nil
+	}
+	if idx, err := strconv.Atoi(lc); err == nil {
+		if idx < 0 || idx > 255 {
+			return nil, fmt.Errorf("palette color index %d out of range", idx)
+		}
+		return tccolor.PaletteColor(idx), nil
+	}
+	if c := tccolor.GetColor(lc); c.Valid() {
+		return c, nil
+	}
+	return nil, fmt.Errorf("unknown color %q", s)
+}
+
+// formatColor returns the string form of the supplied color, as understood by
+// parseColor.
+func formatColor(c types.Color) string {
+	if c == nil {
+		return ""
+	}
+	if tc, ok := c.(tccolor.Color); ok {
+		switch {
+		case tc == tccolor.Default:
+			return "default"
+		case tc.IsRGB():
+			return fmt.Sprintf("#%06x", tc.Hex())
+		case tc.Valid():
+			return strconv.Itoa(int(tc &^ tccolor.IsValid))
+		}
+		return ""
+	}
+	if _, _, _, a := c.RGBA(); a == 0 {
+		return "transparent"
+	}
+	cc, _ := colorful.MakeColor(c)
+	return cc.Hex()
+}
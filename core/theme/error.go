@@ -0,0 +1,31 @@
+package theme
+
+import (
+	"fmt"
+)
+
+// LoadError describes a problem loading a Theme from a file.
+type LoadError struct {
+	// Path is the path of the file, if known.
+	Path string
+	// Line is the line in the file where the problem was found, starting at
+	// 1. Line is 0 if the line is not known.
+	Line int
+	// Err is the underlying problem.
+	Err error
+}
+
+func (e *LoadError) Error() string {
+	prefix := e.Path
+	if prefix == "" {
+		prefix = "theme"
+	}
+	if e.Line > 0 {
+		return fmt.Sprintf("%s:%d: %s", prefix, e.Line, e.Err)
+	}
+	return fmt.Sprintf("%s: %s", prefix, e.Err)
+}
+
+func (e *LoadError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,197 @@
+package theme
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+
+	"github.com/jaypipes/gt/core/border"
+	"github.com/jaypipes/gt/types"
+)
+
+// ExportFile writes the supplied Theme to a theme file at the supplied path.
+// The file's format is determined from its extension.
+func ExportFile(path string, t *Theme) error {
+	format, err := FormatFromPath(path)
+	if err != nil {
+		return err
+	}
+	buf := &bytes.Buffer{}
+	if err := Export(buf, t, format); err != nil {
+		return err
+	}
+	return os.WriteFile(path, buf.Bytes(), 0o644)
+}
+
+// Export writes the supplied Theme to the supplied writer as a theme file in
+// the supplied format. The written file can be read back with [Load].
+func Export(w io.Writer, t *Theme, format Format) error {
+	spec := ToSpec(t)
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(spec)
+	case FormatYAML:
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(spec); err != nil {
+			return err
+		}
+		return enc.Close()
+	case FormatTOML:
+		return toml.NewEncoder(w).Encode(spec)
+	}
+	return fmt.Errorf("unknown theme file format %q", format)
+}
+
+// ToSpec returns the declarative Spec describing the supplied Theme.
+//
+// Each Style and Border in the Theme is named after the ThemeClass that uses
+// it, followed by the Motif state for Styles and Borders in a Motif, e.g.
+// "gt.primary.focused".
+func ToSpec(t *Theme) *Spec {
+	spec := &Spec{
+		Styles:  map[string]StyleSpec{},
+		Borders: map[string]BorderSpec{},
+		Classes: map[string]ClassSpec{},
+	}
+	for _, class := range t.classes() {
+		name := string(class)
+		cs := ClassSpec{}
+		if s := t.Style(class); s != nil {
+			spec.Styles[name] = styleSpec(s)
+			cs.Style = name
+		}
+		if b := t.Border(class); b != nil {
+			spec.Borders[name] = borderSpec(b)
+			cs.Border = name
+		}
+		if m := t.Motif(class); m != nil {
+			cs.Motif = &MotifSpec{
+				Normal:   stateSpec(spec, name+".normal", m.NormalStyle(), m.NormalBorder()),
+				Disabled: stateSpec(spec, name+".disabled", m.DisabledStyle(), m.DisabledBorder()),
+				Focused:  stateSpec(spec, name+".focused", m.FocusedStyle(), m.FocusedBorder()),
+				Hovered:  stateSpec(spec, name+".hovered", m.HoveredStyle(), m.HoveredBorder()),
+				Pressed:  stateSpec(spec, name+".pressed", m.PressedStyle(), m.PressedBorder()),
+				Selected: stateSpec(spec, name+".selected", m.SelectedStyle(), m.SelectedBorder()),
+				Checked:  stateSpec(spec, name+".checked", m.CheckedStyle(), m.CheckedBorder()),
+				Invalid:  stateSpec(spec, name+".invalid", m.InvalidStyle(), m.InvalidBorder()),
+			}
+		}
+		spec.Classes[name] = cs
+	}
+	return spec
+}
+
+// classes returns the sorted ThemeClasses that the Theme has a Motif, Style
+// or Border for.
+func (t *Theme) classes() []types.ThemeClass {
+	seen := map[types.ThemeClass]bool{}
+	for c := range t.motifs {
+		seen[c] = true
+	}
+	for c := range t.styles {
+		seen[c] = true
+	}
+	for c := range t.borders {
+		seen[c] = true
+	}
+	classes := make([]types.ThemeClass, 0, len(seen))
+	for c := range seen {
+		classes = append(classes, c)
+	}
+	sort.Slice(classes, func(i, j int) bool { return classes[i] < classes[j] })
+	return classes
+}
+
+// stateSpec adds the supplied Style and Border of a Motif state to the
+// supplied Spec under the supplied name and returns the StateSpec referring
+// to them, or nil if both are nil.
+func stateSpec(
+	spec *Spec,
+	name string,
+	s types.Style,
+	b types.Border,
+) *StateSpec {
+	if s == nil && b == nil {
+		return nil
+	}
+	ss := &StateSpec{}
+	if s != nil {
+		spec.Styles[name] = styleSpec(s)
+		ss.Style = name
+	}
+	if b != nil {
+		spec.Borders[name] = borderSpec(b)
+		ss.Border = name
+	}
+	return ss
+}
+
+// styleSpec returns the StyleSpec describing the supplied Style.
+func styleSpec(s types.Style) StyleSpec {
+	ss := StyleSpec{
+		Foreground:     formatColor(s.ForegroundColor()),
+		Background:     formatColor(s.BackgroundColor()),
+		UnderlineColor: formatColor(s.UnderlineColor()),
+		Bold:           s.Bold(),
+		Dim:            s.Dim(),
+		Italic:         s.Italic(),
+		Blink:          s.Blink(),
+		Reverse:        s.Reverse(),
+		Strikethrough:  s.Strikethrough(),
+	}
+	if ul := s.UnderlineStyle(); ul != types.UnderlineStyleNone {
+		for name, u := range underlineStyles {
+			if u == ul {
+				ss.Underline = name
+			}
+		}
+	}
+	return ss
+}
+
+// borderSpec returns the BorderSpec describing the supplied Border. If the
+// Border's glyphs match those of a built-in or registered Border, the
+// BorderSpec refers to that variant instead of listing the glyphs.
+func borderSpec(b types.Border) BorderSpec {
+	bs := BorderSpec{
+		Foreground: formatColor(b.ForegroundColor()),
+		Background: formatColor(b.BackgroundColor()),
+	}
+	glyphs := borderGlyphs(b)
+	for _, name := range border.Names() {
+		v, _ := border.Lookup(name)
+		if borderGlyphs(v) == glyphs {
+			bs.Variant = name
+			return bs
+		}
+	}
+	if spec := border.Spec(b); spec != "" {
+		bs.Glyphs = spec
+		return bs
+	}
+	bs.T, bs.B, bs.L, bs.R = glyphs[0], glyphs[1], glyphs[2], glyphs[3]
+	bs.TL, bs.TR, bs.BL, bs.BR = glyphs[4], glyphs[5], glyphs[6], glyphs[7]
+	return bs
+}
+
+// borderGlyphs returns the content of the supplied Border's edges and corners
+// in T, B, L, R, TL, TR, BL, BR order.
+func borderGlyphs(b types.Border) [8]string {
+	cells := [8]types.Cell{b.T(), b.B(), b.L(), b.R(), b.TL(), b.TR(), b.BL(), b.BR()}
+	glyphs := [8]string{}
+	for x, c := range cells {
+		if c != nil {
+			glyphs[x] = c.Content()
+		}
+	}
+	return glyphs
+}
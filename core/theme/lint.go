@@ -0,0 +1,216 @@
+package theme
+
+import (
+	"fmt"
+
+	"github.com/jaypipes/gt/core/palette"
+	"github.com/jaypipes/gt/types"
+)
+
+// ContrastIssue describes a foreground and background color pair in a Theme
+// whose contrast ratio is below the required minimum.
+type ContrastIssue struct {
+	// Class is the ThemeClass having the color pair.
+	Class types.ThemeClass
+	// State is the Motif state having the color pair, e.g. "focused", or
+	// empty for the ThemeClass's Style. States ending in ".border" refer to
+	// the state's Border.
+	State string
+	// Foreground is the foreground color.
+	Foreground types.Color
+	// Background is the background color.
+	Background types.Color
+	// Ratio is the contrast ratio between the colors.
+	Ratio float64
+	// Minimum is the required contrast ratio.
+	Minimum float64
+}
+
+func (i ContrastIssue) String() string {
+	name := string(i.Class)
+	if i.State != "" {
+		name += "." + i.State
+	}
+	return fmt.Sprintf(
+		"%s: contrast %.2f:1 between %s and %s is below %.2f:1",
+		name, i.Ratio, formatColor(i.Foreground), formatColor(i.Background),
+		i.Minimum,
+	)
+}
+
+// lintStates are the Motif states checked by Lint. The disabled state is not
+// checked, since WCAG 2 exempts the text of inactive user interface
+// components from the contrast requirements.
+var lintStates = []struct {
+	name   string
+	style  func(types.Motif) types.Style
+	border func(types.Motif) types.Border
+}{
+	{"normal", types.Motif.NormalStyle, types.Motif.NormalBorder},
+	{"focused", types.Motif.FocusedStyle, types.Motif.FocusedBorder},
+	{"hovered", types.Motif.HoveredStyle, types.Motif.HoveredBorder},
+	{"pressed", types.Motif.PressedStyle, types.Motif.PressedBorder},
+	{"selected", types.Motif.SelectedStyle, types.Motif.SelectedBorder},
+	{"checked", types.Motif.CheckedStyle, types.Motif.CheckedBorder},
+	{"invalid", types.Motif.InvalidStyle, types.Motif.InvalidBorder},
+}
+
+// builtinClasses are the ThemeClasses checked by Lint for Themes that cannot
+// list their ThemeClasses.
+var builtinClasses = []types.ThemeClass{
+	types.ThemeClassInput,
+	types.ThemeClassNavigation,
+	types.ThemeClassPrimary,
+	types.ThemeClassSecondary,
+}
+
+// Lint returns the foreground and background color pairs in the supplied
+// Theme whose contrast ratio is below the supplied minimum, such as
+// [palette.ContrastAA] or [palette.ContrastAAA].
+//
+// The Style of each ThemeClass and the Style and Border of every state but
+// the disabled state of each ThemeClass's Motif are checked. Disabled things
+// are inactive and exempt from the WCAG contrast requirements. A Motif state that does not set a
+// color uses the color of the Motif's normal Style and then of the
+// ThemeClass's Style. Pairs missing either color or using a terminal default
+// color are skipped, since the terminal's default colors are not known.
+func Lint(t types.Theme, minimum float64) []ContrastIssue {
+	return lint(t, minimum, false)
+}
+
+// FixContrast adjusts the foreground colors of the color pairs in the
+// supplied Theme whose contrast ratio is below the supplied minimum, using
+// [palette.AdjustContrast], and returns the issues found before adjusting.
+//
+// The Theme's Styles and Borders are modified in place, which also affects
+// any other Theme sharing them, such as Themes built from the same Scheme.
+func FixContrast(t types.Theme, minimum float64) []ContrastIssue {
+	return lint(t, minimum, true)
+}
+
+// linter checks and optionally fixes the color pairs in a Theme.
+type linter struct {
+	minimum float64
+	fix     bool
+	issues  []ContrastIssue
+}
+
+// lint checks and optionally fixes the color pairs in the supplied Theme.
+func lint(t types.Theme, minimum float64, fix bool) []ContrastIssue {
+	l := &linter{minimum: minimum, fix: fix, issues: []ContrastIssue{}}
+	classes := builtinClasses
+	if tt, ok := t.(*Theme); ok {
+		classes = tt.classes()
+	}
+	for _, class := range classes {
+		base := t.Style(class)
+		l.checkStyle(class, "", base)
+		m := t.Motif(class)
+		if m == nil {
+			continue
+		}
+		normal := m.NormalStyle()
+		for _, st := range lintStates {
+			s := st.style(m)
+			fallback := []types.Style{normal, base}
+			if st.name == "normal" {
+				fallback = fallback[1:]
+			}
+			l.checkStyle(class, st.name, s, fallback...)
+			b := st.border(m)
+			if b == nil {
+				continue
+			}
+			_, bg := styleColors(s, fallback...)
+			if b.BackgroundColor() != nil {
+				bg = b.BackgroundColor()
+			}
+			l.check(
+				class, st.name+".border", b.ForegroundColor(), bg,
+				func(c types.Color) { b.SetForegroundColor(c) },
+			)
+		}
+	}
+	return l.issues
+}
+
+// checkStyle checks the color pair of the supplied Style, using the colors of
+// the fallback Styles for any colors it does not set.
+func (l *linter) checkStyle(
+	class types.ThemeClass,
+	state string,
+	s types.Style,
+	fallback ...types.Style,
+) {
+	if s == nil {
+		return
+	}
+	fg, bg := styleColors(s, fallback...)
+	set := s.SetForegroundColor
+	if s.Reverse() {
+		fg, bg = bg, fg
+		set = s.SetBackgroundColor
+	}
+	l.check(class, state, fg, bg, set)
+}
+
+// check records an issue if the supplied color pair is below the minimum
+// contrast ratio and, when fixing, calls set with the adjusted foreground
+// color.
+func (l *linter) check(
+	class types.ThemeClass,
+	state string,
+	fg, bg types.Color,
+	set func(types.Color),
+) {
+	if !known(fg) || !known(bg) {
+		return
+	}
+	ratio := palette.ContrastRatio(fg, bg)
+	if ratio >= l.minimum {
+		return
+	}
+	l.issues = append(l.issues, ContrastIssue{
+		Class:      class,
+		State:      state,
+		Foreground: fg,
+		Background: bg,
+		Ratio:      ratio,
+		Minimum:    l.minimum,
+	})
+	if l.fix {
+		set(palette.AdjustContrast(fg, bg, l.minimum))
+	}
+}
+
+// styleColors returns the foreground and background colors of the supplied
+// Style, using the colors of the fallback Styles, in order, for any colors
+// the Style does not set.
+func styleColors(
+	s types.Style,
+	fallback ...types.Style,
+) (types.Color, types.Color) {
+	var fg, bg types.Color
+	for _, s := range append([]types.Style{s}, fallback...) {
+		if s == nil {
+			continue
+		}
+		if fg == nil {
+			fg = s.ForegroundColor()
+		}
+		if bg == nil {
+			bg = s.BackgroundColor()
+		}
+	}
+	return fg, bg
+}
+
+// known returns true if the supplied color is set and is not a terminal
+// default or transparent color.
+func known(c types.Color) bool {
+	if c == nil {
+		return false
+	}
+	_, _, _, a := c.RGBA()
+	return a != 0
+}
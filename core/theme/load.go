@@ -0,0 +1,459 @@
+package theme
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+
+	"github.com/jaypipes/gt/core/border"
+	"github.com/jaypipes/gt/core/cell"
+	"github.com/jaypipes/gt/core/motif"
+	"github.com/jaypipes/gt/core/style"
+	"github.com/jaypipes/gt/types"
+)
+
+// Format is the file format of a theme file.
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+	FormatTOML Format = "toml"
+)
+
+// FormatFromPath returns the Format of the theme file at the supplied path,
+// based on the file's extension.
+func FormatFromPath(path string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return FormatJSON, nil
+	case ".yaml", ".yml":
+		return FormatYAML, nil
+	case ".toml":
+		return FormatTOML, nil
+	}
+	return "", fmt.Errorf("unknown theme file format for %q", path)
+}
+
+var (
+	// reYAMLLine matches the line number in YAML decoding errors.
+	reYAMLLine = regexp.MustCompile(`line (\d+):`)
+	// reJSONUnknownField matches the field name in JSON unknown field errors.
+	reJSONUnknownField = regexp.MustCompile(`unknown field "([^"]+)"`)
+)
+
+// LoadFile returns a new Theme built from the JSON, YAML or TOML theme file at
+// the supplied path. The file's format is determined from its extension.
+//
+// Any returned error is a *LoadError containing the line in the file where
+// the problem was found.
+func LoadFile(path string) (*Theme, error) {
+	format, err := FormatFromPath(path)
+	if err != nil {
+		return nil, &LoadError{Path: path, Err: err}
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, &LoadError{Path: path, Err: err}
+	}
+	defer f.Close()
+	t, err := Load(f, format)
+	var le *LoadError
+	if errors.As(err, &le) {
+		le.Path = path
+	}
+	return t, err
+}
+
+// Load returns a new Theme built from the theme file contents in the supplied
+// format read from the supplied reader.
+//
+// Any returned error is a *LoadError containing the line in the file where
+// the problem was found.
+func Load(r io.Reader, format Format) (*Theme, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, &LoadError{Err: err}
+	}
+	spec, pos, err := decode(data, format)
+	if err != nil {
+		return nil, err
+	}
+	return build(spec, pos)
+}
+
+// decode decodes the supplied theme file contents into a Spec, returning the
+// Spec along with the positions of the keys in the file.
+func decode(data []byte, format Format) (*Spec, positions, error) {
+	spec := &Spec{}
+	switch format {
+	case FormatJSON:
+		pos := jsonPositions(data)
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(spec); err != nil {
+			le := &LoadError{Err: err}
+			var se *json.SyntaxError
+			var te *json.UnmarshalTypeError
+			switch {
+			case errors.As(err, &se):
+				le.Line = lineAt(data, se.Offset)
+			case errors.As(err, &te):
+				le.Line = lineAt(data, te.Offset)
+			default:
+				if m := reJSONUnknownField.FindStringSubmatch(err.Error()); m != nil {
+					le.Line = pos.lineOf(m[1])
+				}
+			}
+			return nil, nil, le
+		}
+		return spec, pos, nil
+	case FormatYAML:
+		node := &yaml.Node{}
+		if err := yaml.Unmarshal(data, node); err != nil {
+			return nil, nil, yamlError(err)
+		}
+		if node.Kind == 0 {
+			return spec, positions{}, nil
+		}
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(spec); err != nil {
+			return nil, nil, yamlError(err)
+		}
+		return spec, yamlPositions(node), nil
+	case FormatTOML:
+		pos := tomlPositions(data)
+		md, err := toml.NewDecoder(bytes.NewReader(data)).Decode(spec)
+		if err != nil {
+			le := &LoadError{Err: err}
+			var pe toml.ParseError
+			if errors.As(err, &pe) {
+				le.Line = pe.Position.Line
+				le.Err = errors.New(pe.Message)
+			}
+			return nil, nil, le
+		}
+		if undecoded := md.Undecoded(); len(undecoded) > 0 {
+			key := undecoded[0]
+			return nil, nil, &LoadError{
+				Line: pos.line(key...),
+				Err:  fmt.Errorf("unknown field %q", key.String()),
+			}
+		}
+		return spec, pos, nil
+	}
+	return nil, nil, &LoadError{Err: fmt.Errorf("unknown theme file format %q", format)}
+}
+
+// yamlError returns a *LoadError for the supplied YAML decoding error.
+func yamlError(err error) *LoadError {
+	le := &LoadError{Err: err}
+	msg := err.Error()
+	var te *yaml.TypeError
+	if errors.As(err, &te) && len(te.Errors) > 0 {
+		msg = te.Errors[0]
+	}
+	if m := reYAMLLine.FindStringSubmatchIndex(msg); m != nil {
+		le.Line, _ = strconv.Atoi(msg[m[2]:m[3]])
+		le.Err = errors.New(strings.TrimSpace(msg[m[1]:]))
+	}
+	return le
+}
+
+// builder builds a Theme from a Spec, tracking the Styles and Borders that
+// have already been built by name.
+type builder struct {
+	spec    *Spec
+	pos     positions
+	styles  map[string]types.Style
+	borders map[string]types.Border
+}
+
+// build returns a new Theme built from the supplied Spec.
+func build(spec *Spec, pos positions) (*Theme, error) {
+	b := &builder{
+		spec:    spec,
+		pos:     pos,
+		styles:  map[string]types.Style{},
+		borders: map[string]types.Border{},
+	}
+	for _, name := range sortedKeys(spec.Palette) {
+		if _, err := parseColor(spec.Palette[name], nil); err != nil {
+			return nil, b.errorf(err, "palette", name)
+		}
+	}
+	for _, name := range sortedKeys(spec.Styles) {
+		s, err := b.buildStyle(name, spec.Styles[name])
+		if err != nil {
+			return nil, err
+		}
+		b.styles[name] = s
+	}
+	for _, name := range sortedKeys(spec.Borders) {
+		bd, err := b.buildBorder(name, spec.Borders[name])
+		if err != nil {
+			return nil, err
+		}
+		b.borders[name] = bd
+	}
+	t := New()
+	for _, name := range sortedKeys(spec.Classes) {
+		cs := spec.Classes[name]
+		class := types.ThemeClass(name)
+		path := []string{"classes", name}
+		if cs.Style != "" {
+			s, err := b.style(cs.Style, append(path, "style"))
+			if err != nil {
+				return nil, err
+			}
+			t.SetStyle(class, s)
+		}
+		if cs.Border != "" {
+			bd, err := b.border(cs.Border, append(path, "border"))
+			if err != nil {
+				return nil, err
+			}
+			t.SetBorder(class, bd)
+		}
+		if cs.Motif != nil {
+			m, err := b.buildMotif(cs.Motif, append(path, "motif"))
+			if err != nil {
+				return nil, err
+			}
+			t.SetMotif(class, m)
+		}
+	}
+	return t, nil
+}
+
+// errorf returns a *LoadError for the supplied error found at the supplied
+// path of keys.
+func (b *builder) errorf(err error, path ...string) *LoadError {
+	return &LoadError{Line: b.pos.line(path...), Err: err}
+}
+
+// buildStyle returns a new Style from the supplied StyleSpec.
+func (b *builder) buildStyle(name string, ss StyleSpec) (types.Style, error) {
+	path := []string{"styles", name}
+	s := style.New()
+	colors := []struct {
+		key    string
+		value  string
+		setter func(types.Color)
+	}{
+		{"foreground", ss.Foreground, s.SetForegroundColor},
+		{"background", ss.Background, s.SetBackgroundColor},
+		{"underline_color", ss.UnderlineColor, s.SetUnderlineColor},
+	}
+	for _, c := range colors {
+		if c.value == "" {
+			continue
+		}
+		col, err := parseColor(c.value, b.spec.Palette)
+		if err != nil {
+			return nil, b.errorf(err, append(path, c.key)...)
+		}
+		c.setter(col)
+	}
+	if ss.Underline != "" {
+		ul, ok := underlineStyles[strings.ToLower(ss.Underline)]
+		if !ok {
+			return nil, b.errorf(
+				fmt.Errorf("unknown underline style %q", ss.Underline),
+				append(path, "underline")...,
+			)
+		}
+		s.SetUnderlineStyle(ul)
+	}
+	s.SetBold(ss.Bold)
+	s.SetDim(ss.Dim)
+	s.SetItalic(ss.Italic)
+	s.SetBlink(ss.Blink)
+	s.SetReverse(ss.Reverse)
+	s.SetStrikethrough(ss.Strikethrough)
+	return s, nil
+}
+
+// buildBorder returns a new Border from the supplied BorderSpec.
+func (b *builder) buildBorder(name string, bs BorderSpec) (types.Border, error) {
+	path := []string{"borders", name}
+	bd := border.None()
+	if bs.Variant != "" {
+		v, ok := border.Lookup(bs.Variant)
+		if !ok {
+			return nil, b.errorf(
+				fmt.Errorf("unknown border variant %q", bs.Variant),
+				append(path, "variant")...,
+			)
+		}
+		bd = v
+	}
+	if len(bs.Sides) != 0 {
+		s, err := borderSides(bs.Sides)
+		if err != nil {
+			return nil, b.errorf(err, append(path, "sides")...)
+		}
+		bd = s
+	}
+	if bs.Glyphs != "" {
+		g, err := border.FromSpec(bs.Glyphs)
+		if err != nil {
+			return nil, b.errorf(err, append(path, "glyphs")...)
+		}
+		bd = g
+	}
+	edges := []struct {
+		glyph  string
+		setter func(types.Cell)
+	}{
+		{bs.T, bd.SetT}, {bs.B, bd.SetB}, {bs.L, bd.SetL}, {bs.R, bd.SetR},
+		{bs.TL, bd.SetTL}, {bs.TR, bd.SetTR}, {bs.BL, bd.SetBL}, {bs.BR, bd.SetBR},
+	}
+	for _, e := range edges {
+		if e.glyph != "" {
+			e.setter(cell.New(cell.WithContent(e.glyph)))
+		}
+	}
+	if bs.Foreground != "" {
+		c, err := parseColor(bs.Foreground, b.spec.Palette)
+		if err != nil {
+			return nil, b.errorf(err, append(path, "foreground")...)
+		}
+		bd.SetForegroundColor(c)
+	}
+	if bs.Background != "" {
+		c, err := parseColor(bs.Background, b.spec.Palette)
+		if err != nil {
+			return nil, b.errorf(err, append(path, "background")...)
+		}
+		bd.SetBackgroundColor(c)
+	}
+	return bd, nil
+}
+
+// borderSides returns a new Border with the edges of the named border
+// variants. Like the CSS border-style shorthand, one name applies to all
+// sides, two names to the top and bottom then the left and right, three names
+// to the top, the left and right, then the bottom, and four names to the top,
+// right, bottom and left.
+func borderSides(names []string) (types.Border, error) {
+	var idx [4]int
+	switch len(names) {
+	case 1:
+		idx = [4]int{0, 0, 0, 0}
+	case 2:
+		idx = [4]int{0, 1, 0, 1}
+	case 3:
+		idx = [4]int{0, 1, 2, 1}
+	case 4:
+		idx = [4]int{0, 1, 2, 3}
+	default:
+		return nil, fmt.Errorf(
+			"expected 1 to 4 border variants but got %d", len(names),
+		)
+	}
+	sides := [4]types.Border{}
+	for x, i := range idx {
+		v, ok := border.Lookup(names[i])
+		if !ok {
+			return nil, fmt.Errorf("unknown border variant %q", names[i])
+		}
+		sides[x] = v
+	}
+	return border.Sides(sides[0], sides[1], sides[2], sides[3]), nil
+}
+
+// buildMotif returns a new Motif from the supplied MotifSpec.
+func (b *builder) buildMotif(ms *MotifSpec, path []string) (types.Motif, error) {
+	m := motif.Empty()
+	states := []struct {
+		key          string
+		spec         *StateSpec
+		styleSetter  func(types.Style)
+		borderSetter func(types.Border)
+	}{
+		{"normal", ms.Normal, m.SetNormalStyle, m.SetNormalBorder},
+		{"disabled", ms.Disabled, m.SetDisabledStyle, m.SetDisabledBorder},
+		{"focused", ms.Focused, m.SetFocusedStyle, m.SetFocusedBorder},
+		{"hovered", ms.Hovered, m.SetHoveredStyle, m.SetHoveredBorder},
+		{"pressed", ms.Pressed, m.SetPressedStyle, m.SetPressedBorder},
+		{"selected", ms.Selected, m.SetSelectedStyle, m.SetSelectedBorder},
+		{"checked", ms.Checked, m.SetCheckedStyle, m.SetCheckedBorder},
+		{"invalid", ms.Invalid, m.SetInvalidStyle, m.SetInvalidBorder},
+	}
+	for _, st := range states {
+		if st.spec == nil {
+			continue
+		}
+		sp := append(path[:len(path):len(path)], st.key)
+		if st.spec.Style != "" {
+			s, err := b.style(st.spec.Style, append(sp, "style"))
+			if err != nil {
+				return nil, err
+			}
+			st.styleSetter(s)
+		}
+		if st.spec.Border != "" {
+			bd, err := b.border(st.spec.Border, append(sp, "border"))
+			if err != nil {
+				return nil, err
+			}
+			st.borderSetter(bd)
+		}
+	}
+	return m, nil
+}
+
+// style returns the Style with the supplied name, referred to at the supplied
+// path of keys.
+func (b *builder) style(name string, path []string) (types.Style, error) {
+	s, ok := b.styles[name]
+	if !ok {
+		return nil, b.errorf(fmt.Errorf("undefined style %q", name), path...)
+	}
+	return s, nil
+}
+
+// border returns the Border with the supplied name, referred to at the
+// supplied path of keys.
+func (b *builder) border(name string, path []string) (types.Border, error) {
+	bd, ok := b.borders[name]
+	if !ok {
+		return nil, b.errorf(fmt.Errorf("undefined border %q", name), path...)
+	}
+	return bd, nil
+}
+
+// sortedKeys returns the keys of the supplied map in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+var (
+	// underlineStyles maps underline style names to UnderlineStyles.
+	underlineStyles = map[string]types.UnderlineStyle{
+		"none":   types.UnderlineStyleNone,
+		"solid":  types.UnderlineStyleSolid,
+		"double": types.UnderlineStyleDouble,
+		"curly":  types.UnderlineStyleCurly,
+		"dotted": types.UnderlineStyleDotted,
+		"dashed": types.UnderlineStyleDashed,
+	}
+)
@@ -9,7 +9,11 @@ import (
 // You can pass zero or more ThemeWithOptions to optionally set certain
 // attributes on the returned Theme.
 func New(opts ...types.ThemeWithOption) *Theme {
-	p := &Theme{}
+	p := &Theme{
+		motifs:  map[types.ThemeClass]types.Motif{},
+		styles:  map[types.ThemeClass]types.Style{},
+		borders: map[types.ThemeClass]types.Border{},
+	}
 	for _, opt := range opts {
 		opt(p)
 	}
@@ -0,0 +1,23 @@
+package theme
+
+import (
+	"github.com/jaypipes/gt/core/motif"
+	"github.com/jaypipes/gt/core/palette"
+	"github.com/jaypipes/gt/types"
+)
+
+var (
+	// NordDark is a Theme using the dark variant of the Nord color palette.
+	NordDark = FromScheme(
+		palette.NordDarkScheme,
+		WithMotif(types.ThemeClassPrimary, motif.NordDarkPrimary),
+	)
+	// NordLight is a Theme using the light variant of the Nord color palette.
+	NordLight = FromScheme(
+		palette.NordLightScheme,
+		WithMotif(types.ThemeClassPrimary, motif.NordLightPrimary),
+	)
+	// Default is the Theme used by Elements that have no Theme of their own
+	// and no ancestor, View or Application with a Theme.
+	Default = NordDark
+)
@@ -0,0 +1,200 @@
+package theme
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// positions maps the path of keys in a theme file to the line that the key is
+// found on.
+type positions map[string]int
+
+// pathKey returns the key in positions for the supplied path of keys.
+func pathKey(path []string) string {
+	return strings.Join(path, "\x00")
+}
+
+// line returns the line of the supplied path of keys, or of the nearest
+// enclosing key that has a known line. It returns 0 if no line is known.
+func (p positions) line(path ...string) int {
+	for n := len(path); n > 0; n-- {
+		if l, ok := p[pathKey(path[:n])]; ok {
+			return l
+		}
+	}
+	return 0
+}
+
+// lineOf returns the line of the first key having the supplied name, or 0 if
+// there is no such key.
+func (p positions) lineOf(name string) int {
+	line := 0
+	for k, l := range p {
+		parts := strings.Split(k, "\x00")
+		if parts[len(parts)-1] == name && (line == 0 || l < line) {
+			line = l
+		}
+	}
+	return line
+}
+
+// lineAt returns the line containing the supplied byte offset in data.
+func lineAt(data []byte, offset int64) int {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+	return bytes.Count(data[:offset], []byte("\n")) + 1
+}
+
+// jsonPositions returns the positions of the keys in the supplied JSON
+// document.
+func jsonPositions(data []byte) positions {
+	pos := positions{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	var walk func(path []string) error
+	walk = func(path []string) error {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch tok {
+		case json.Delim('{'):
+			for dec.More() {
+				kt, err := dec.Token()
+				if err != nil {
+					return err
+				}
+				key, _ := kt.(string)
+				p := append(path[:len(path):len(path)], key)
+				pos[pathKey(p)] = lineAt(data, dec.InputOffset())
+				if err := walk(p); err != nil {
+					return err
+				}
+			}
+			_, err = dec.Token()
+		case json.Delim('['):
+			for x := 0; dec.More(); x++ {
+				if err := walk(append(path[:len(path):len(path)], strconv.Itoa(x))); err != nil {
+					return err
+				}
+			}
+			_, err = dec.Token()
+		}
+		return err
+	}
+	// Syntax errors are reported when decoding the document itself.
+	_ = walk(nil)
+	return pos
+}
+
+// yamlPositions returns the positions of the keys in the supplied YAML
+// document node.
+func yamlPositions(node *yaml.Node) positions {
+	pos := positions{}
+	var walk func(n *yaml.Node, path []string)
+	walk = func(n *yaml.Node, path []string) {
+		switch n.Kind {
+		case yaml.DocumentNode:
+			for _, c := range n.Content {
+				walk(c, path)
+			}
+		case yaml.MappingNode:
+			for x := 0; x+1 < len(n.Content); x += 2 {
+				k := n.Content[x]
+				p := append(path[:len(path):len(path)], k.Value)
+				pos[pathKey(p)] = k.Line
+				walk(n.Content[x+1], p)
+			}
+		case yaml.SequenceNode:
+			for x, c := range n.Content {
+				walk(c, append(path[:len(path):len(path)], strconv.Itoa(x)))
+			}
+		}
+	}
+	walk(node, nil)
+	return pos
+}
+
+// tomlPositions returns the positions of the keys in the supplied TOML
+// document. Only table headers and `key = value` lines are considered; keys
+// within inline tables are given the line of the inline table's key.
+func tomlPositions(data []byte) positions {
+	pos := positions{}
+	var table []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || text[0] == '#' {
+			continue
+		}
+		if text[0] == '[' {
+			header := strings.TrimLeft(text, "[")
+			keys, _ := splitTOMLKey(header)
+			table = keys
+			for n := 1; n <= len(keys); n++ {
+				if _, ok := pos[pathKey(keys[:n])]; !ok {
+					pos[pathKey(keys[:n])] = line
+				}
+			}
+			continue
+		}
+		keys, rest := splitTOMLKey(text)
+		if len(keys) == 0 || !strings.HasPrefix(rest, "=") {
+			continue
+		}
+		p := append(table[:len(table):len(table)], keys...)
+		for n := len(table) + 1; n <= len(p); n++ {
+			if _, ok := pos[pathKey(p[:n])]; !ok {
+				pos[pathKey(p[:n])] = line
+			}
+		}
+	}
+	return pos
+}
+
+// splitTOMLKey splits the dotted TOML key at the start of the supplied text
+// into its parts, returning the parts and the remaining text after the key.
+func splitTOMLKey(text string) ([]string, string) {
+	keys := []string{}
+	for {
+		text = strings.TrimLeft(text, " \t")
+		if text == "" {
+			return keys, ""
+		}
+		var key string
+		switch text[0] {
+		case '"', '\'':
+			end := strings.IndexByte(text[1:], text[0])
+			if end < 0 {
+				return keys, ""
+			}
+			key = text[1 : end+1]
+			if text[0] == '"' {
+				if uq, err := strconv.Unquote(text[:end+2]); err == nil {
+					key = uq
+				}
+			}
+			text = text[end+2:]
+		default:
+			end := strings.IndexAny(text, " \t.=]")
+			if end < 0 {
+				end = len(text)
+			}
+			key = text[:end]
+			text = text[end:]
+		}
+		keys = append(keys, key)
+		text = strings.TrimLeft(text, " \t")
+		if !strings.HasPrefix(text, ".") {
+			return keys, text
+		}
+		text = text[1:]
+	}
+}
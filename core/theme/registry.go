@@ -0,0 +1,90 @@
+package theme
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/jaypipes/gt/types"
+)
+
+const (
+	suffixDark  = "-dark"
+	suffixLight = "-light"
+)
+
+// variants is a pair of Themes for dark and light terminal backgrounds.
+type variants struct {
+	dark  types.Theme
+	light types.Theme
+}
+
+var (
+	registryLock sync.RWMutex
+	registry     = map[string]variants{
+		"nord":        {NordDark, NordLight},
+		"solarized":   {SolarizedDark, SolarizedLight},
+		"gruvbox":     {GruvboxDark, GruvboxLight},
+		"dracula":     {DraculaDark, DraculaLight},
+		"catppuccin":  {CatppuccinDark, CatppuccinLight},
+		"tokyo-night": {TokyoNightDark, TokyoNightLight},
+	}
+)
+
+// Register adds a named pair of Themes to the registry, replacing any pair
+// already registered with that name. The dark Theme is used on terminals
+// with dark backgrounds and the light Theme on terminals with light
+// backgrounds. Names are case-insensitive.
+func Register(name string, dark, light types.Theme) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	registry[strings.ToLower(name)] = variants{dark, light}
+}
+
+// Lookup returns the dark and light Themes registered with the supplied name
+// and whether the name is registered.
+func Lookup(name string) (dark, light types.Theme, ok bool) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	v, ok := registry[strings.ToLower(name)]
+	return v.dark, v.light, ok
+}
+
+// Names returns the sorted names of all registered Themes.
+func Names() []string {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Select returns the registered Theme with the supplied name that suits a
+// terminal with a dark background if darkBackground is true, or a light
+// background otherwise. A "-dark" or "-light" suffix on the name, for example
+// "gruvbox-light", selects that variant regardless of the terminal
+// background. If name is empty or not registered, the Nord Themes are used.
+func Select(name string, darkBackground bool) types.Theme {
+	dark, light, ok := Lookup(name)
+	if !ok {
+		lower := strings.ToLower(name)
+		switch {
+		case strings.HasSuffix(lower, suffixDark):
+			darkBackground = true
+			dark, light, ok = Lookup(strings.TrimSuffix(lower, suffixDark))
+		case strings.HasSuffix(lower, suffixLight):
+			darkBackground = false
+			dark, light, ok = Lookup(strings.TrimSuffix(lower, suffixLight))
+		}
+	}
+	if !ok {
+		dark, light = NordDark, NordLight
+	}
+	if darkBackground {
+		return dark
+	}
+	return light
+}
@@ -0,0 +1,59 @@
+package theme
+
+import (
+	"github.com/jaypipes/gt/core/motif"
+	"github.com/jaypipes/gt/core/palette"
+	"github.com/jaypipes/gt/types"
+)
+
+// schemeClasses are the ThemeClasses that FromScheme sets Motifs for.
+var schemeClasses = []types.ThemeClass{
+	types.ThemeClassPrimary,
+	types.ThemeClassSecondary,
+	types.ThemeClassInput,
+	types.ThemeClassNavigation,
+}
+
+// FromScheme returns a new Theme with a Motif for each of the built-in
+// ThemeClasses, with colors taken from the roles of the supplied palette
+// Scheme.
+//
+// You can pass zero or more ThemeWithOptions to override the generated Motifs
+// or set additional attributes on the returned Theme.
+func FromScheme(
+	s *palette.Scheme,
+	opts ...types.ThemeWithOption,
+) *Theme {
+	t := New()
+	for _, class := range schemeClasses {
+		t.SetMotif(class, motif.FromScheme(s, class))
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+var (
+	// SolarizedDark is a Theme using the dark Solarized color scheme.
+	SolarizedDark = FromScheme(palette.SolarizedDarkScheme)
+	// SolarizedLight is a Theme using the light Solarized color scheme.
+	SolarizedLight = FromScheme(palette.SolarizedLightScheme)
+	// GruvboxDark is a Theme using the dark Gruvbox color scheme.
+	GruvboxDark = FromScheme(palette.GruvboxDarkScheme)
+	// GruvboxLight is a Theme using the light Gruvbox color scheme.
+	GruvboxLight = FromScheme(palette.GruvboxLightScheme)
+	// DraculaDark is a Theme using the Dracula color scheme.
+	DraculaDark = FromScheme(palette.DraculaDarkScheme)
+	// DraculaLight is a Theme using the Alucard color scheme, Dracula's
+	// light counterpart.
+	DraculaLight = FromScheme(palette.DraculaLightScheme)
+	// CatppuccinDark is a Theme using the Catppuccin Mocha color scheme.
+	CatppuccinDark = FromScheme(palette.CatppuccinDarkScheme)
+	// CatppuccinLight is a Theme using the Catppuccin Latte color scheme.
+	CatppuccinLight = FromScheme(palette.CatppuccinLightScheme)
+	// TokyoNightDark is a Theme using the Tokyo Night color scheme.
+	TokyoNightDark = FromScheme(palette.TokyoNightDarkScheme)
+	// TokyoNightLight is a Theme using the Tokyo Night Day color scheme.
+	TokyoNightLight = FromScheme(palette.TokyoNightLightScheme)
+)
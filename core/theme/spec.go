@@ -0,0 +1,90 @@
+package theme
+
+// Spec is the declarative description of a Theme that is read from and
+// written to JSON, YAML and TOML files.
+//
+// Colors are written as a hex string ("#88c0d0"), an ANSI palette index
+// ("0" to "255"), a W3C color name ("steelblue"), "transparent", "default" or
+// the name of a color in the Spec's Palette. Styles and Borders are defined
+// once by name and then referred to by name from the Spec's ThemeClasses.
+type Spec struct {
+	// Palette maps color names to colors.
+	Palette map[string]string `json:"palette,omitempty" yaml:"palette,omitempty" toml:"palette,omitempty"`
+	// Styles maps style names to Style definitions.
+	Styles map[string]StyleSpec `json:"styles,omitempty" yaml:"styles,omitempty" toml:"styles,omitempty"`
+	// Borders maps border names to Border definitions.
+	Borders map[string]BorderSpec `json:"borders,omitempty" yaml:"borders,omitempty" toml:"borders,omitempty"`
+	// Classes maps ThemeClass names to the styling for that ThemeClass.
+	Classes map[string]ClassSpec `json:"classes,omitempty" yaml:"classes,omitempty" toml:"classes,omitempty"`
+}
+
+// StyleSpec is the declarative description of a Style.
+type StyleSpec struct {
+	Foreground     string `json:"foreground,omitempty" yaml:"foreground,omitempty" toml:"foreground,omitempty"`
+	Background     string `json:"background,omitempty" yaml:"background,omitempty" toml:"background,omitempty"`
+	UnderlineColor string `json:"underline_color,omitempty" yaml:"underline_color,omitempty" toml:"underline_color,omitempty"`
+	// Underline is one of "none", "solid", "double", "curly", "dotted" or
+	// "dashed".
+	Underline     string `json:"underline,omitempty" yaml:"underline,omitempty" toml:"underline,omitempty"`
+	Bold          bool   `json:"bold,omitempty" yaml:"bold,omitempty" toml:"bold,omitempty"`
+	Dim           bool   `json:"dim,omitempty" yaml:"dim,omitempty" toml:"dim,omitempty"`
+	Italic        bool   `json:"italic,omitempty" yaml:"italic,omitempty" toml:"italic,omitempty"`
+	Blink         bool   `json:"blink,omitempty" yaml:"blink,omitempty" toml:"blink,omitempty"`
+	Reverse       bool   `json:"reverse,omitempty" yaml:"reverse,omitempty" toml:"reverse,omitempty"`
+	Strikethrough bool   `json:"strikethrough,omitempty" yaml:"strikethrough,omitempty" toml:"strikethrough,omitempty"`
+}
+
+// BorderSpec is the declarative description of a Border.
+//
+// Variant names one of the built-in or registered Borders, e.g. "normal",
+// "rounded" or "inner-half-block". Sides names one to four Borders whose edges
+// make up the top, right, bottom and left sides, following the CSS
+// border-style shorthand, e.g. ["dashed", "normal"]. Glyphs lists the edge and
+// corner glyphs in row order, e.g. "╭─╮│ │╰─╯". Any edge or corner glyphs that
+// are set override those of the Variant, Sides or Glyphs.
+type BorderSpec struct {
+	Variant    string   `json:"variant,omitempty" yaml:"variant,omitempty" toml:"variant,omitempty"`
+	Sides      []string `json:"sides,omitempty" yaml:"sides,omitempty" toml:"sides,omitempty"`
+	Glyphs     string   `json:"glyphs,omitempty" yaml:"glyphs,omitempty" toml:"glyphs,omitempty"`
+	T          string   `json:"t,omitempty" yaml:"t,omitempty" toml:"t,omitempty"`
+	B          string   `json:"b,omitempty" yaml:"b,omitempty" toml:"b,omitempty"`
+	L          string   `json:"l,omitempty" yaml:"l,omitempty" toml:"l,omitempty"`
+	R          string   `json:"r,omitempty" yaml:"r,omitempty" toml:"r,omitempty"`
+	TL         string   `json:"tl,omitempty" yaml:"tl,omitempty" toml:"tl,omitempty"`
+	TR         string   `json:"tr,omitempty" yaml:"tr,omitempty" toml:"tr,omitempty"`
+	BL         string   `json:"bl,omitempty" yaml:"bl,omitempty" toml:"bl,omitempty"`
+	BR         string   `json:"br,omitempty" yaml:"br,omitempty" toml:"br,omitempty"`
+	Foreground string   `json:"foreground,omitempty" yaml:"foreground,omitempty" toml:"foreground,omitempty"`
+	Background string   `json:"background,omitempty" yaml:"background,omitempty" toml:"background,omitempty"`
+}
+
+// ClassSpec is the declarative description of the styling for a ThemeClass.
+type ClassSpec struct {
+	// Style is the name of the ThemeClass's Style.
+	Style string `json:"style,omitempty" yaml:"style,omitempty" toml:"style,omitempty"`
+	// Border is the name of the ThemeClass's Border.
+	Border string `json:"border,omitempty" yaml:"border,omitempty" toml:"border,omitempty"`
+	// Motif is the ThemeClass's Motif.
+	Motif *MotifSpec `json:"motif,omitempty" yaml:"motif,omitempty" toml:"motif,omitempty"`
+}
+
+// MotifSpec is the declarative description of a Motif.
+type MotifSpec struct {
+	Normal   *StateSpec `json:"normal,omitempty" yaml:"normal,omitempty" toml:"normal,omitempty"`
+	Disabled *StateSpec `json:"disabled,omitempty" yaml:"disabled,omitempty" toml:"disabled,omitempty"`
+	Focused  *StateSpec `json:"focused,omitempty" yaml:"focused,omitempty" toml:"focused,omitempty"`
+	Hovered  *StateSpec `json:"hovered,omitempty" yaml:"hovered,omitempty" toml:"hovered,omitempty"`
+	Pressed  *StateSpec `json:"pressed,omitempty" yaml:"pressed,omitempty" toml:"pressed,omitempty"`
+	Selected *StateSpec `json:"selected,omitempty" yaml:"selected,omitempty" toml:"selected,omitempty"`
+	Checked  *StateSpec `json:"checked,omitempty" yaml:"checked,omitempty" toml:"checked,omitempty"`
+	Invalid  *StateSpec `json:"invalid,omitempty" yaml:"invalid,omitempty" toml:"invalid,omitempty"`
+}
+
+// StateSpec is the declarative description of the styling of a Motif in a
+// single state.
+type StateSpec struct {
+	// Style is the name of the Style for the state.
+	Style string `json:"style,omitempty" yaml:"style,omitempty" toml:"style,omitempty"`
+	// Border is the name of the Border for the state.
+	Border string `json:"border,omitempty" yaml:"border,omitempty" toml:"border,omitempty"`
+}
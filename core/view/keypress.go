@@ -44,7 +44,7 @@ func (v *View) SetKeyShortcut(shortcut types.KeyShortcut) {
 		if ksk.Equal(k) {
 			gtlog.Warn(
 				context.TODO(),
-				"key shortcut %q shadows previously-registered "+
+				"key shortcut %q is shadowed by previously-registered "+
 					"view-level key shortcut",
 				k,
 			)
@@ -53,6 +53,11 @@ func (v *View) SetKeyShortcut(shortcut types.KeyShortcut) {
 	v.keyShortcuts = append(v.keyShortcuts, shortcut)
 }
 
+// KeyShortcuts returns the View-level KeyShortcuts.
+func (v *View) KeyShortcuts() []types.KeyShortcut {
+	return v.keyShortcuts
+}
+
 // KeyPress checks for any KeyShortcuts that are registered withe View and
 // executes any matched callback. If no KeyShortcuts are matched, we execute
 // the View's internal vdiv Element's KeyPress method.
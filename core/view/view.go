@@ -21,6 +21,10 @@ type View struct {
 
 	// keyShortcuts stores the View's set of key shortcuts.
 	keyShortcuts []types.KeyShortcut
+
+	// redrawer is the thing, typically the Application, that redraws the
+	// View when its Theme changes.
+	redrawer types.Redrawer
 }
 
 // String returns a short string representation of the View.
@@ -46,6 +50,28 @@ func (v *View) WithBorder(border types.Border) types.View {
 	return v
 }
 
+// SetThemeProvider sets the thing, typically the Application, that provides
+// the Theme for the View's Elements when neither the View nor any of those
+// Elements has a Theme.
+func (v *View) SetThemeProvider(p types.ThemeProvider) {
+	v.VDiv.SetThemeProvider(p)
+	v.redrawer, _ = p.(types.Redrawer)
+}
+
+// SetTheme sets the View's Theme, overriding the Application's Theme for all
+// Elements in the View that do not have a Theme of their own.
+//
+// SetTheme may be called while the Application is running. Since the new
+// Theme's Borders may take up more or less space than the old Theme's, the
+// layout of the View is invalidated and the View is redrawn.
+func (v *View) SetTheme(t types.Theme) {
+	v.VDiv.SetTheme(t)
+	render.Invalidate(context.Background(), v)
+	if v.redrawer != nil {
+		v.redrawer.Redraw()
+	}
+}
+
 // SetContent sets the thing that will be rendered in the View.
 func (v *View) SetContent(content types.Node) {
 	v.RemoveAllChildren()
@@ -91,6 +117,9 @@ func (v *View) Draw(
 	// Allow any components to dynamically create renderable content.
 	render.Build(ctx, v)
 
+	// Compute the properties of all content from any Stylesheets.
+	render.Cascade(ctx, v)
+
 	// Then recursively plot all content in the View.
 	render.Plot(ctx, v, inner)
 
@@ -0,0 +1,142 @@
+package element
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/jaypipes/gt/core/render"
+	"github.com/jaypipes/gt/core/style"
+	"github.com/jaypipes/gt/types"
+)
+
+// ANSIMode returns how ANSI escape sequences in the Element's text content
+// are handled.
+func (e *Element) ANSIMode() types.ANSIMode {
+	return e.ansiMode
+}
+
+// SetANSIMode sets how ANSI escape sequences in the Element's text content
+// are handled.
+func (e *Element) SetANSIMode(mode types.ANSIMode) {
+	e.ansiMode = mode
+}
+
+// WithANSIMode sets how ANSI escape sequences in the Element's text content
+// are handled and returns the Element.
+func (e *Element) WithANSIMode(mode types.ANSIMode) types.Element {
+	e.ansiMode = mode
+	return e
+}
+
+// renderRuns renders the supplied aligned text content within the supplied
+// inner bounding box, styling each line's text using the supplied styled
+// Runs. The Runs of each source line are placed where render.Align placed
+// that line for the supplied Alignment. Any cells that were added by
+// alignment are rendered using the supplied base Style without its
+// hyperlink. The Element's
+// gradients, if any, color every cell except where a Run's SGR sequences set
+// the color.
+func (e *Element) renderRuns(
+	screen types.Screen,
+	content string,
+	runs []style.Run,
+	inner types.Rectangle,
+	align types.Alignment,
+	base types.Style,
+) {
+	lines := strings.Split(content, "\n")
+	fill := unlinked(base)
+	for y, line := range lines {
+		putCells(
+			screen, inner.Min.X, inner.Min.Y+y, line,
+			func(pt types.Point) types.Style {
+				return e.cellStyle(fill, pt)
+			},
+		)
+	}
+	srcLines := runLines(runs)
+	top := render.PadTop(len(srcLines), inner.Dy(), align)
+	for n, src := range srcLines {
+		y := top + n
+		if y >= len(lines) {
+			break
+		}
+		x := inner.Min.X + render.PadLeft(
+			ansi.StringWidth(runsText(src)), inner.Dx(), align,
+		)
+		for _, run := range src {
+			x = putCells(
+				screen, x, inner.Min.Y+y, run.Text,
+				func(pt types.Point) types.Style {
+					return e.runCellStyle(run.Style, base, pt)
+				},
+			)
+		}
+	}
+}
+
+// putCells puts the supplied text on the screen one grapheme at a time,
+// starting at the supplied coordinates and styling each cell with the Style
+// that the supplied function returns for the cell's point. It returns the X
+// coordinate following the text.
+func putCells(
+	screen types.Screen,
+	x, y int,
+	text string,
+	styleAt func(types.Point) types.Style,
+) int {
+	for len(text) > 0 {
+		st := style.TCell(styleAt(types.Point{X: x, Y: y}))
+		rest, width := screen.Put(x, y, text, st)
+		if len(rest) == len(text) {
+			break
+		}
+		text = rest
+		x += width
+	}
+	return x
+}
+
+// runLines splits the supplied Runs into lines of Runs on newlines.
+func runLines(runs []style.Run) [][]style.Run {
+	lines := [][]style.Run{{}}
+	for _, run := range runs {
+		parts := strings.Split(run.Text, "\n")
+		for x, part := range parts {
+			if x > 0 {
+				lines = append(lines, []style.Run{})
+			}
+			if len(part) == 0 {
+				continue
+			}
+			last := len(lines) - 1
+			lines[last] = append(
+				lines[last], style.Run{Text: part, Style: run.Style},
+			)
+		}
+	}
+	return lines
+}
+
+// runsText returns the plain text of the supplied Runs.
+func runsText(runs []style.Run) string {
+	sb := &strings.Builder{}
+	for _, run := range runs {
+		sb.WriteString(run.Text)
+	}
+	return sb.String()
+}
+
+// expandRunTabs returns the supplied Runs with any tab characters replaced with
+// four spaces.
+func expandRunTabs(runs []style.Run) []style.Run {
+	out := make([]style.Run, len(runs))
+	for x, run := range runs {
+		out[x] = style.Run{
+			Text:  strings.ReplaceAll(run.Text, "\t", "    "),
+			Style: run.Style,
+		}
+	}
+	return out
+}
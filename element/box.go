@@ -12,6 +12,21 @@ func (e *Element) WithBounds(bounds types.Rectangle) types.Element {
 	return e
 }
 
+// InnerBounds returns the inner bounding box for the Element, which is the
+// outer bounding box adjusted for the Element's appropriate Border and
+// padding.
+func (e *Element) InnerBounds() types.Rectangle {
+	bounds := e.Bounds()
+	border := e.Border()
+	if border != nil {
+		bounds.Min.X += int(border.LSize())
+		bounds.Min.Y += int(border.TSize())
+		bounds.Max.X -= int(border.RSize())
+		bounds.Max.Y -= int(border.BSize())
+	}
+	return e.Box.Padding().AdjustBounds(bounds)
+}
+
 // WithAbsolutePosition sets the Element's outer bounding box's top-left
 // coordinates and marks the Element as using absolute positioning and returns
 // the Element.
@@ -20,34 +35,51 @@ func (e *Element) WithAbsolutePosition(pt types.Point) types.Element {
 	return e
 }
 
-// Border returns the Element's appropriate Border. If the Element has the
-// focus and a FocusBorder, this returns the FocusBorder. If the mouse is
-// hovering over the Element and there is a non-nil HoverBorder, this returns
-// the HoverBorder. Otherwise, this returns the normal Border, if any.
+// Border returns the Element's appropriate Border. If the Element is
+// disabled, pressed, invalid, focused, checked, selected or hovered over, this
+// returns the Border for the first of those states that has one set.
+// Otherwise, this returns the normal Border, if any.
+//
+// Each of these borders is looked up first in the Element's own Motif and
+// then in the Motif that the Element's Theme has for the Element's
+// ThemeClass, falling back to the Border that the Theme has for the Element's
+// ThemeClass. A Border declared for the Element by its Stylesheets takes
+// precedence over all of these.
 func (e *Element) Border() types.Border {
-	m := e.motif
-	if m == nil {
-		return nil
+	if b, ok := e.declaredBorder(); ok {
+		return b
 	}
-	if e.disabled {
-		db := m.DisabledBorder()
-		if db != nil {
-			return db
+	motifs := e.motifs()
+	for _, st := range motifStates {
+		if !st.active(e) {
+			continue
 		}
-	}
-	if e.focused {
-		fb := m.FocusedBorder()
-		if fb != nil {
-			return fb
+		for _, m := range motifs {
+			if b := st.border(m); b != nil {
+				return b
+			}
 		}
 	}
-	if e.hovered {
-		hb := m.HoveredBorder()
-		if hb != nil {
-			return hb
+	return e.normalBorder()
+}
+
+// normalBorder returns the Element's normal Border, looked up first in the
+// Element's own Motif, then in the Motif that the Element's Theme has for the
+// Element's ThemeClass and finally the Border that the Theme has for the
+// Element's ThemeClass.
+func (e *Element) normalBorder() types.Border {
+	for _, m := range e.motifs() {
+		if nb := m.NormalBorder(); nb != nil {
+			return nb
 		}
 	}
-	return m.NormalBorder()
+	if e.themeClass == types.ThemeClassNone {
+		return nil
+	}
+	if t := e.Theme(); t != nil {
+		return t.Border(e.themeClass)
+	}
+	return nil
 }
 
 // SetBorder sets the Element's normal border. The normal border is Element's
@@ -147,6 +179,102 @@ func (e *Element) WithHoveredBorder(border types.Border) types.Element {
 	return e
 }
 
+// PressedBorder returns the Element's border when a mouse button is held down
+// on the Element.
+func (e *Element) PressedBorder() types.Border {
+	if e.motif == nil {
+		return nil
+	}
+	return e.motif.PressedBorder()
+}
+
+// SetPressedBorder sets the Element's border when a mouse button is held down
+// on the Element.
+func (e *Element) SetPressedBorder(border types.Border) {
+	if e.motif == nil {
+		e.motif = motif.Empty()
+	}
+	e.motif.SetPressedBorder(border)
+}
+
+// WithPressedBorder sets the Element's border when a mouse button is held down
+// on the Element and returns the Element.
+func (e *Element) WithPressedBorder(border types.Border) types.Element {
+	e.SetPressedBorder(border)
+	return e
+}
+
+// SelectedBorder returns the Element's border when the Element is selected.
+func (e *Element) SelectedBorder() types.Border {
+	if e.motif == nil {
+		return nil
+	}
+	return e.motif.SelectedBorder()
+}
+
+// SetSelectedBorder sets the Element's border when the Element is selected.
+func (e *Element) SetSelectedBorder(border types.Border) {
+	if e.motif == nil {
+		e.motif = motif.Empty()
+	}
+	e.motif.SetSelectedBorder(border)
+}
+
+// WithSelectedBorder sets the Element's border when the Element is selected and
+// returns the Element.
+func (e *Element) WithSelectedBorder(border types.Border) types.Element {
+	e.SetSelectedBorder(border)
+	return e
+}
+
+// CheckedBorder returns the Element's border when the Element is checked.
+func (e *Element) CheckedBorder() types.Border {
+	if e.motif == nil {
+		return nil
+	}
+	return e.motif.CheckedBorder()
+}
+
+// SetCheckedBorder sets the Element's border when the Element is checked.
+func (e *Element) SetCheckedBorder(border types.Border) {
+	if e.motif == nil {
+		e.motif = motif.Empty()
+	}
+	e.motif.SetCheckedBorder(border)
+}
+
+// WithCheckedBorder sets the Element's border when the Element is checked and
+// returns the Element.
+func (e *Element) WithCheckedBorder(border types.Border) types.Element {
+	e.SetCheckedBorder(border)
+	return e
+}
+
+// InvalidBorder returns the Element's border when the Element has an invalid
+// value.
+func (e *Element) InvalidBorder() types.Border {
+	if e.motif == nil {
+		return nil
+	}
+	return e.motif.InvalidBorder()
+}
+
+// SetInvalidBorder sets the Element's border when the Element has an invalid
+// value.
+func (e *Element) SetInvalidBorder(border types.Border) {
+	if e.motif == nil {
+		e.motif = motif.Empty()
+	}
+	e.motif.SetInvalidBorder(border)
+}
+
+// WithInvalidBorder sets the Element's border when the Element has an invalid
+// value and returns the Element.
+func (e *Element) WithInvalidBorder(border types.Border) types.Element {
+	e.SetInvalidBorder(border)
+	return e
+}
+
 // WithBorderForegroundColor sets the Element's border foreground color (i.e
 // the color of the border cell's underlying grapheme) and returns the Element.
 func (e *Element) WithBorderForegroundColor(c types.Color) types.Element {
@@ -161,6 +289,76 @@ func (e *Element) WithBorderBackgroundColor(c types.Color) types.Element {
 	return e
 }
 
+// WithShadow sets the Element's drop shadow and returns the Element.
+func (e *Element) WithShadow(shadow types.Shadow) types.Element {
+	e.Box.SetShadow(shadow)
+	return e
+}
+
+// BorderTitle returns the label embedded in the top edge of the Element's
+// border, if any. If no title has been set on the Element itself, the title
+// of the Element's border for its current state is returned, falling back to
+// the title of the Element's normal border so that the title is kept when
+// the border changes on focus, hover or disable.
+func (e *Element) BorderTitle() types.BorderLabel {
+	if e.borderTitle != nil {
+		return e.borderTitle
+	}
+	border := e.Border()
+	if border != nil && border.Title() != nil {
+		return border.Title()
+	}
+	if nb := e.normalBorder(); nb != nil {
+		return nb.Title()
+	}
+	return nil
+}
+
+// SetBorderTitle sets the label embedded in the top edge of the Element's
+// border.
+func (e *Element) SetBorderTitle(label types.BorderLabel) {
+	e.borderTitle = label
+}
+
+// WithBorderTitle sets the label embedded in the top edge of the Element's
+// border and returns the Element.
+func (e *Element) WithBorderTitle(label types.BorderLabel) types.Element {
+	e.borderTitle = label
+	return e
+}
+
+// BorderFooter returns the label embedded in the bottom edge of the Element's
+// border, if any. If no footer has been set on the Element itself, the footer
+// of the Element's border for its current state is returned, falling back to
+// the footer of the Element's normal border so that the footer is kept when
+// the border changes on focus, hover or disable.
+func (e *Element) BorderFooter() types.BorderLabel {
+	if e.borderFooter != nil {
+		return e.borderFooter
+	}
+	border := e.Border()
+	if border != nil && border.Footer() != nil {
+		return border.Footer()
+	}
+	if nb := e.normalBorder(); nb != nil {
+		return nb.Footer()
+	}
+	return nil
+}
+
+// SetBorderFooter sets the label embedded in the bottom edge of the Element's
+// border.
+func (e *Element) SetBorderFooter(label types.BorderLabel) {
+	e.borderFooter = label
+}
+
+// WithBorderFooter sets the label embedded in the bottom edge of the Element's
+// border and returns the Element.
+func (e *Element) WithBorderFooter(label types.BorderLabel) types.Element {
+	e.borderFooter = label
+	return e
+}
+
 // WithPadding sets the Element's padding and returns the Element.
 func (e *Element) WithPadding(padding types.Padding) types.Element {
 	e.Box.SetPadding(padding)
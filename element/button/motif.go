@@ -1,16 +1,17 @@
 package button
 
 import (
-	"github.com/jaypipes/gt/core/motif"
-	"github.com/jaypipes/gt/core/palette"
+	"github.com/jaypipes/gt/core/theme"
+	"github.com/jaypipes/gt/types"
 )
 
 var (
-	DefaultMotif = motif.NordDarkPrimary
+	// DefaultMotif is the Motif of the default Theme for
+	// types.ThemeClassPrimary, which Buttons are styled with unless another
+	// Theme or Motif applies to them.
+	//
+	// Deprecated: Buttons resolve their Motif from the Theme in effect for
+	// their ThemeClass. Use theme.Select and Theme.Motif, or a Button's Motif
+	// method, instead.
+	DefaultMotif = theme.Select("", true).Motif(types.ThemeClassPrimary)
 )
-
-func init() {
-	if !palette.TerminalHasDarkBackground {
-		DefaultMotif = motif.NordLightPrimary
-	}
-}
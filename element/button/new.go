@@ -21,7 +21,7 @@ func New(
 	b.SetDisplay(types.DisplayInlineBlock)
 	b.SetAlignment(types.AlignmentTopLeft)
 	b.SetWhitespace(types.WhitespacePreserve)
-	b.SetMotif(DefaultMotif)
+	b.SetThemeClass(types.ThemeClassPrimary)
 	// Button is an input element so should be able to receive the focus. Note
 	// that the MouseClick action for a Button releases the focus immediately
 	// after the mouse clicks on the button. This is done so that the hover
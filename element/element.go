@@ -37,13 +37,45 @@ type Element struct {
 	theme types.Theme
 	// themeClass is the ThemeClass this Element belongs to.
 	themeClass types.ThemeClass
+	// themeProvider provides the Theme for the Element when neither the
+	// Element nor any of its ancestors has a Theme. This is set on the root
+	// Element of a View.
+	themeProvider types.ThemeProvider
 	// motif encapsulates the different styles and borders of the Element in
 	// different states (having the focus, being disabled, being hovered over
 	// by the mouse, and "normal")
 	motif types.Motif
+	// stylesheet is the Stylesheet whose Rules apply to the Element and its
+	// descendants.
+	stylesheet types.Stylesheet
+	// stylesheetProvider provides the outermost Stylesheet for the Element
+	// and its descendants. This is set on the root Element of a View.
+	stylesheetProvider types.StylesheetProvider
+	// declared contains the values of the Properties declared for the
+	// Element by its Stylesheets, as of the last Cascade.
+	declared map[types.Property]any
+	// undeclared contains the Element's own values of layout Properties that
+	// have been replaced by declared values, so that they can be restored
+	// when no longer declared.
+	undeclared map[types.Property]any
+	// borderTitle is the label embedded in the top edge of the Element's
+	// border, regardless of the Element's state.
+	borderTitle types.BorderLabel
+	// borderFooter is the label embedded in the bottom edge of the Element's
+	// border, regardless of the Element's state.
+	borderFooter types.BorderLabel
 
 	// textContent is any unstyle raw text content for the Element.
 	textContent string
+	// fgGradient is the optional Gradient used for the foreground color of
+	// the Element's text content.
+	fgGradient types.Gradient
+	// bgGradient is the optional Gradient used to fill the Element's
+	// background inside its border.
+	bgGradient types.Gradient
+	// ansiMode describes how any ANSI escape sequences in the text content
+	// are handled when rendering.
+	ansiMode types.ANSIMode
 
 	// focusable indicates the Element can receive the focus (when not
 	// disabled). This is generally a static property of a class of Elements.
@@ -56,6 +88,15 @@ type Element struct {
 	// hovered is true if the mouse is currently over the Element but the
 	// Element does *not* have the current focus.
 	hovered bool
+	// pressed is true if a mouse button is currently held down on the
+	// Element.
+	pressed bool
+	// selected is true if the Element is selected.
+	selected bool
+	// checked is true if the Element is checked.
+	checked bool
+	// invalid is true if the Element has an invalid value.
+	invalid bool
 
 	// onFocus contains the stack of callbacks that execute when the Element
 	// receives or loses focus.
@@ -63,6 +104,9 @@ type Element struct {
 	// onKeyPress contains the stack of callbacks that execute when a keypress
 	// event occurs.
 	onKeyPress []types.KeyPressEventCallback
+	// keyShortcuts contains the KeyShortcuts that are active while the focus
+	// is within the Element's subtree.
+	keyShortcuts []types.KeyShortcut
 	// onScroll contains the stack of callbacks that execute when a scroll
 	// event occurs.
 	onScroll []types.ScrollEventCallback
@@ -70,6 +114,9 @@ type Element struct {
 	// Element is hovered over by the mouse but the Element does *not* have the
 	// focus or when the Element no longer has the mouse hovering over it.
 	onMouseHover []types.MouseHoverEventCallback
+	// onMousePress contains the stack of callbacks that execute when a mouse
+	// button is pressed or released on the Element.
+	onMousePress []types.MousePressEventCallback
 	// onMouseClick contains the stack of callbacks that execute when the
 	// Element is clicked on by the mouse.
 	onMouseClick []types.MouseClickEventCallback
@@ -151,6 +198,16 @@ func (e *Element) Render(ctx context.Context, h types.ScreenHandler) {
 	e.RenderBox(ctx, h)
 
 	content := e.TextContent()
+	// When honoring ANSI SGR sequences, the text content is parsed into styled
+	// runs and the plain text of those runs is what gets aligned.
+	var runs []style.Run
+	switch e.ansiMode {
+	case types.ANSIModeStrip:
+		content = style.StripANSI(content)
+	case types.ANSIModeHonor:
+		runs = style.ParseSGR(content, s)
+		content = runsText(runs)
+	}
 	if len(content) == 0 {
 		return
 	}
@@ -174,6 +231,9 @@ func (e *Element) Render(ctx context.Context, h types.ScreenHandler) {
 		// to render.Align already pre-padded with spaces.
 		sb := &strings.Builder{}
 		content = strings.ReplaceAll(content, "\t", "    ")
+		if runs != nil {
+			runs = expandRunTabs(runs)
+		}
 		lines := strings.Split(content, "\n")
 		maxWidth := lo.Max(lo.Map(lines, func(line string, _ int) int {
 			return len(line)
@@ -185,7 +245,7 @@ func (e *Element) Render(ctx context.Context, h types.ScreenHandler) {
 				sb.WriteString(pad)
 			}
 			sb.WriteString(line)
-			if x < len(line)-1 {
+			if x < len(lines)-1 {
 				sb.WriteRune('\n')
 			}
 		}
@@ -194,16 +254,31 @@ func (e *Element) Render(ctx context.Context, h types.ScreenHandler) {
 	content = render.Align(
 		ctx, content, inner, align, whitespace,
 	)
+	if runs != nil {
+		e.renderRuns(screen, content, runs, inner, align, s)
+		e.RenderPadding(ctx, h)
+		return
+	}
 	lines := strings.Split(content, "\n")
 	innerMinX := inner.Min.X
 	innerMinY := inner.Min.Y
+	fill := unlinked(s)
 	for y, line := range lines {
+		// The spaces that alignment added around the text of the line are
+		// styled without the Element's hyperlink.
+		textStart := len(line) - len(strings.TrimLeft(line, " "))
+		textEnd := len(strings.TrimRight(line, " "))
 		for x := range line {
+			pt := types.Point{X: innerMinX + x, Y: innerMinY + y}
+			cs := s
+			if x < textStart || x >= textEnd {
+				cs = fill
+			}
 			screen.Put(
-				innerMinX+x,
-				innerMinY+y,
+				pt.X,
+				pt.Y,
 				string(line[x]),
-				style.TCell(s),
+				style.TCell(e.cellStyle(cs, pt)),
 			)
 		}
 	}
@@ -212,23 +287,34 @@ func (e *Element) Render(ctx context.Context, h types.ScreenHandler) {
 }
 
 // RenderBox ensures that the underlying Box has its appropriate border set
-// (depending on the Element's state) and renders the Box.
+// (depending on the Element's state) along with the border's title and footer
+// and renders the Box.
 func (e *Element) RenderBox(ctx context.Context, h types.ScreenHandler) {
 	border := e.Border()
 	e.Box.SetBorder(border)
+	e.Box.SetBorderTitle(e.BorderTitle())
+	e.Box.SetBorderFooter(e.BorderFooter())
+	collapsed := false
+	if parent, ok := e.Parent().(types.Borderable); ok {
+		collapsed = parent.BorderCollapse()
+	}
+	e.Box.SetCollapsed(collapsed)
 	e.Box.Render(ctx, h)
+	e.renderBackgroundGradient(ctx, h)
 }
 
 // RenderPadding ensures that any padding cells are rendered with their
 // appropriate content style.
 func (e *Element) RenderPadding(ctx context.Context, h types.ScreenHandler) {
 	padding := e.Padding()
-	if padding.Empty() {
+	if padding.Empty() || e.bgGradient != nil {
+		// When there is a background gradient, the padding cells have already
+		// been filled by RenderBox.
 		return
 	}
 	inner := e.InnerBounds()
 	contentHeight := int(e.Height() - e.VerticalSpace())
-	s := e.Style()
+	s := unlinked(e.Style())
 	screen := h.Screen()
 
 	lp := padding.L
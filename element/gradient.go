@@ -0,0 +1,127 @@
+package element
+
+import (
+	"context"
+
+	"github.com/jaypipes/gt/core/style"
+	"github.com/jaypipes/gt/types"
+)
+
+// ForegroundGradient returns the Gradient used for the foreground color of the
+// Element's text content, if any.
+func (e *Element) ForegroundGradient() types.Gradient {
+	return e.fgGradient
+}
+
+// SetForegroundGradient sets the Gradient used for the foreground color of the
+// Element's text content. When set, the Gradient takes precedence over the
+// foreground color of the Element's Style.
+func (e *Element) SetForegroundGradient(gradient types.Gradient) {
+	e.fgGradient = gradient
+}
+
+// WithForegroundGradient sets the Gradient used for the foreground color of the
+// Element's text content and returns the Element.
+func (e *Element) WithForegroundGradient(gradient types.Gradient) types.Element {
+	e.fgGradient = gradient
+	return e
+}
+
+// BackgroundGradient returns the Gradient used to fill the Element's
+// background, if any.
+func (e *Element) BackgroundGradient() types.Gradient {
+	return e.bgGradient
+}
+
+// SetBackgroundGradient sets the Gradient used to fill the Element's
+// background inside its border. When set, the Gradient takes precedence over
+// the background color of the Element's Style.
+func (e *Element) SetBackgroundGradient(gradient types.Gradient) {
+	e.bgGradient = gradient
+}
+
+// WithBackgroundGradient sets the Gradient used to fill the Element's
+// background inside its border and returns the Element.
+func (e *Element) WithBackgroundGradient(gradient types.Gradient) types.Element {
+	e.bgGradient = gradient
+	return e
+}
+
+// paddingBounds returns the bounding box inside the Element's border, which
+// includes the Element's padding.
+func (e *Element) paddingBounds() types.Rectangle {
+	inner := e.InnerBounds()
+	padding := e.Padding()
+	inner.Min.X -= int(padding.L)
+	inner.Min.Y -= int(padding.T)
+	inner.Max.X += int(padding.R)
+	inner.Max.Y += int(padding.B)
+	return inner
+}
+
+// cellStyle returns the Style of the cell at the supplied point, computing the
+// cell's foreground and background colors from the Element's gradients, if
+// any. The foreground gradient spans the Element's inner bounding box and the
+// background gradient spans the area inside the Element's border.
+func (e *Element) cellStyle(base types.Style, pt types.Point) types.Style {
+	if e.fgGradient == nil && e.bgGradient == nil {
+		return base
+	}
+	s := style.Clone(base)
+	if e.fgGradient != nil {
+		fg := e.fgGradient.Along(pt, e.InnerBounds())
+		if fg != nil {
+			s.SetForegroundColor(fg)
+		}
+	}
+	if e.bgGradient != nil {
+		bg := e.bgGradient.Along(pt, e.paddingBounds())
+		if bg != nil {
+			s.SetBackgroundColor(bg)
+		}
+	}
+	return s
+}
+
+// runCellStyle returns the Style of the cell at the supplied point of a Run
+// parsed from ANSI SGR sequences with the supplied base Style. The Element's
+// gradients color the cell's foreground and background unless the Run's SGR
+// sequences changed that color from the base Style.
+func (e *Element) runCellStyle(
+	run types.Style,
+	base types.Style,
+	pt types.Point,
+) types.Style {
+	if e.fgGradient == nil && e.bgGradient == nil {
+		return run
+	}
+	s := e.cellStyle(run, pt)
+	b := style.Clone(base)
+	if fg := run.ForegroundColor(); fg != b.ForegroundColor() {
+		s.SetForegroundColor(fg)
+	}
+	if bg := run.BackgroundColor(); bg != b.BackgroundColor() {
+		s.SetBackgroundColor(bg)
+	}
+	return s
+}
+
+// renderBackgroundGradient fills the area inside the Element's border using
+// the Element's background gradient, if any.
+func (e *Element) renderBackgroundGradient(
+	ctx context.Context,
+	h types.ScreenHandler,
+) {
+	if e.bgGradient == nil {
+		return
+	}
+	screen := h.Screen()
+	base := unlinked(e.Style())
+	area := e.paddingBounds()
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		for x := area.Min.X; x < area.Max.X; x++ {
+			pt := types.Point{X: x, Y: y}
+			screen.Put(x, y, " ", style.TCell(e.cellStyle(base, pt)))
+		}
+	}
+}
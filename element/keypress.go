@@ -3,6 +3,7 @@ package element
 import (
 	"context"
 
+	gtlog "github.com/jaypipes/gt/core/log"
 	"github.com/jaypipes/gt/types"
 )
 
@@ -32,3 +33,28 @@ func (e *Element) KeyPress(ctx context.Context, ev types.KeyPressEvent) bool {
 func (e *Element) OnKeyPress(cb types.KeyPressEventCallback) {
 	e.onKeyPress = append(e.onKeyPress, cb)
 }
+
+// SetKeyShortcut registers an Element-level KeyShortcut that will execute upon
+// a key press combination while the Element or one of its descendants has the
+// focus. Element-level KeyShortcuts of the focused Element win over those of
+// its ancestors, which win over View and Application bindings.
+func (e *Element) SetKeyShortcut(shortcut types.KeyShortcut) {
+	k := shortcut.Key()
+	for _, ks := range e.keyShortcuts {
+		ksk := ks.Key()
+		if ksk.Equal(k) {
+			gtlog.Warn(
+				context.TODO(),
+				"key shortcut %q is shadowed by previously-registered "+
+					"element-level key shortcut on %s",
+				k, e.Tag(),
+			)
+		}
+	}
+	e.keyShortcuts = append(e.keyShortcuts, shortcut)
+}
+
+// KeyShortcuts returns the Element-level KeyShortcuts.
+func (e *Element) KeyShortcuts() []types.KeyShortcut {
+	return e.keyShortcuts
+}
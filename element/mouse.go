@@ -15,6 +15,11 @@ func (e *Element) MouseHover(ctx context.Context, ev types.MouseHoverEvent) {
 	}
 }
 
+// Hovered returns true if the mouse is currently over the Element.
+func (e *Element) Hovered() bool {
+	return e.hovered
+}
+
 // OnMouseHover registers a callback that will be executed when the Element is
 // hovered over but does *not* have the focus or when the Element no longer has
 // the mouse hovering over it.
@@ -22,6 +27,27 @@ func (e *Element) OnMouseHover(cb types.MouseHoverEventCallback) {
 	e.onMouseHover = append(e.onMouseHover, cb)
 }
 
+// MousePress records whether a mouse button is held down on the Element and
+// executes any OnMousePress callbacks that were registered for the Element.
+func (e *Element) MousePress(ctx context.Context, ev types.MousePressEvent) {
+	e.pressed = ev.Pressed()
+	for _, cb := range e.onMousePress {
+		cb(ctx, ev)
+	}
+}
+
+// Pressed returns true if a mouse button is currently held down on the
+// Element.
+func (e *Element) Pressed() bool {
+	return e.pressed
+}
+
+// OnMousePress registers a callback that will be executed when a mouse button
+// is pressed down on the Element and when it is released.
+func (e *Element) OnMousePress(cb types.MousePressEventCallback) {
+	e.onMousePress = append(e.onMousePress, cb)
+}
+
 // MouseClick executes any OnMouseClick callbacks that were registered for the
 // Element.
 func (e *Element) MouseClick(ctx context.Context, ev types.MouseClickEvent) {
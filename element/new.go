@@ -42,6 +42,27 @@ func WithDisabled(on bool) types.ElementWithOption {
 	}
 }
 
+// WithSelected sets whether the Element is selected.
+func WithSelected(on bool) types.ElementWithOption {
+	return func(e types.Element) {
+		e.SetSelected(on)
+	}
+}
+
+// WithChecked sets whether the Element is checked.
+func WithChecked(on bool) types.ElementWithOption {
+	return func(e types.Element) {
+		e.SetChecked(on)
+	}
+}
+
+// WithInvalid sets whether the Element has an invalid value.
+func WithInvalid(on bool) types.ElementWithOption {
+	return func(e types.Element) {
+		e.SetInvalid(on)
+	}
+}
+
 // WithFocusable sets whether the Element can receive the focus.
 func WithFocusable(on bool) types.ElementWithOption {
 	return func(e types.Element) {
@@ -141,6 +162,13 @@ func WithTheme(theme types.Theme) types.ElementWithOption {
 	}
 }
 
+// WithStylesheet sets the types.Element's stylesheet to the supplied value.
+func WithStylesheet(s types.Stylesheet) types.ElementWithOption {
+	return func(e types.Element) {
+		e.SetStylesheet(s)
+	}
+}
+
 // WithMotif sets the types.Element's motif to the supplied value.
 func WithMotif(motif types.Motif) types.ElementWithOption {
 	return func(e types.Element) {
@@ -155,6 +183,14 @@ func WithBorder(border types.Border) types.ElementWithOption {
 	}
 }
 
+// WithBorderCollapse sets whether the bordered children of the
+// types.Element share a border line with their adjacent bordered siblings.
+func WithBorderCollapse(on bool) types.ElementWithOption {
+	return func(e types.Element) {
+		e.SetBorderCollapse(on)
+	}
+}
+
 // WithDisabledBorder sets the types.Element's border when the Element is
 // disabled.
 func WithDisabledBorder(border types.Border) types.ElementWithOption {
@@ -179,6 +215,38 @@ func WithHoveredBorder(border types.Border) types.ElementWithOption {
 	}
 }
 
+// WithPressedBorder sets the types.Element's border when a mouse button is held
+// down on the Element to the supplied value.
+func WithPressedBorder(border types.Border) types.ElementWithOption {
+	return func(e types.Element) {
+		e.SetPressedBorder(border)
+	}
+}
+
+// WithSelectedBorder sets the types.Element's border when the Element is
+// selected to the supplied value.
+func WithSelectedBorder(border types.Border) types.ElementWithOption {
+	return func(e types.Element) {
+		e.SetSelectedBorder(border)
+	}
+}
+
+// WithCheckedBorder sets the types.Element's border when the Element is checked
+// to the supplied value.
+func WithCheckedBorder(border types.Border) types.ElementWithOption {
+	return func(e types.Element) {
+		e.SetCheckedBorder(border)
+	}
+}
+
+// WithInvalidBorder sets the types.Element's border when the Element has an
+// invalid value to the supplied value.
+func WithInvalidBorder(border types.Border) types.ElementWithOption {
+	return func(e types.Element) {
+		e.SetInvalidBorder(border)
+	}
+}
+
 // WithBorderForegroundColor sets the types.Element's border foreground color
 // to the supplied value.
 func WithBorderForegroundColor(color types.Color) types.ElementWithOption {
@@ -195,6 +263,29 @@ func WithBorderBackgroundColor(color types.Color) types.ElementWithOption {
 	}
 }
 
+// WithShadow sets the types.Element's drop shadow to the supplied value.
+func WithShadow(shadow types.Shadow) types.ElementWithOption {
+	return func(e types.Element) {
+		e.SetShadow(shadow)
+	}
+}
+
+// WithBorderTitle sets the label embedded in the top edge of the
+// types.Element's border to the supplied value.
+func WithBorderTitle(label types.BorderLabel) types.ElementWithOption {
+	return func(e types.Element) {
+		e.SetBorderTitle(label)
+	}
+}
+
+// WithBorderFooter sets the label embedded in the bottom edge of the
+// types.Element's border to the supplied value.
+func WithBorderFooter(label types.BorderLabel) types.ElementWithOption {
+	return func(e types.Element) {
+		e.SetBorderFooter(label)
+	}
+}
+
 // WithStyle sets the types.Element's style to the supplied value.
 func WithStyle(style types.Style) types.ElementWithOption {
 	return func(e types.Element) {
@@ -224,6 +315,38 @@ func WithHoveredStyle(style types.Style) types.ElementWithOption {
 	}
 }
 
+// WithPressedStyle sets the types.Element's style when a mouse button is held
+// down on the Element to the supplied value.
+func WithPressedStyle(style types.Style) types.ElementWithOption {
+	return func(e types.Element) {
+		e.SetPressedStyle(style)
+	}
+}
+
+// WithSelectedStyle sets the types.Element's style when the Element is selected
+// to the supplied value.
+func WithSelectedStyle(style types.Style) types.ElementWithOption {
+	return func(e types.Element) {
+		e.SetSelectedStyle(style)
+	}
+}
+
+// WithCheckedStyle sets the types.Element's style when the Element is checked
+// to the supplied value.
+func WithCheckedStyle(style types.Style) types.ElementWithOption {
+	return func(e types.Element) {
+		e.SetCheckedStyle(style)
+	}
+}
+
+// WithInvalidStyle sets the types.Element's style when the Element has an
+// invalid value to the supplied value.
+func WithInvalidStyle(style types.Style) types.ElementWithOption {
+	return func(e types.Element) {
+		e.SetInvalidStyle(style)
+	}
+}
+
 // WithForegroundColor sets the types.Element's foreground color to the supplied
 // value.
 func WithForegroundColor(color types.Color) types.ElementWithOption {
@@ -240,6 +363,49 @@ func WithBackgroundColor(color types.Color) types.ElementWithOption {
 	}
 }
 
+// WithHyperlink sets the types.Element's OSC 8 hyperlink to the supplied URL
+// and optional ID.
+func WithHyperlink(url, id string) types.ElementWithOption {
+	return func(e types.Element) {
+		e.SetHyperlink(url, id)
+	}
+}
+
+// WithANSIMode sets how ANSI escape sequences in the types.Element's text
+// content are handled to the supplied value.
+func WithANSIMode(mode types.ANSIMode) types.ElementWithOption {
+	return func(e types.Element) {
+		e.SetANSIMode(mode)
+	}
+}
+
+// WithForegroundGradient sets the Gradient used for the foreground color of the
+// types.Element's text content to the supplied value.
+func WithForegroundGradient(gradient types.Gradient) types.ElementWithOption {
+	return func(e types.Element) {
+		e.SetForegroundGradient(gradient)
+	}
+}
+
+// WithBackgroundGradient sets the Gradient used to fill the types.Element's
+// background to the supplied value.
+func WithBackgroundGradient(gradient types.Gradient) types.ElementWithOption {
+	return func(e types.Element) {
+		e.SetBackgroundGradient(gradient)
+	}
+}
+
+// WithKeyShortcut registers a KeyShortcut on the types.Element that is active
+// while the focus is within the types.Element's subtree. It does nothing if
+// the types.Element is not a types.KeyShortcutHandler.
+func WithKeyShortcut(shortcut types.KeyShortcut) types.ElementWithOption {
+	return func(e types.Element) {
+		if h, ok := e.(types.KeyShortcutHandler); ok {
+			h.SetKeyShortcut(shortcut)
+		}
+	}
+}
+
 // WithTextContent sets the types.Element's text content to the supplied value.
 func WithTextContent(content string) types.ElementWithOption {
 	return func(e types.Element) {
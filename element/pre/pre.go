@@ -28,6 +28,10 @@ func New(
 
 // Pre is an Element that uses block display mode by default and uses a
 // whitespace mode that preserves whitespace characters.
+//
+// To faithfully render the colored output of external commands, pass
+// [element.WithANSIMode] with [types.ANSIModeHonor] when constructing the
+// Pre.
 type Pre struct {
 	element.Element
 }
@@ -0,0 +1,84 @@
+package element
+
+import (
+	"github.com/jaypipes/gt/types"
+)
+
+// motifState is an interaction state of an Element along with the Style and
+// Border that a Motif has for the state.
+type motifState struct {
+	// active returns true if the Element is in the state.
+	active func(*Element) bool
+	// style returns the Motif's Style for the state.
+	style func(types.Motif) types.Style
+	// border returns the Motif's Border for the state.
+	border func(types.Motif) types.Border
+}
+
+// motifStates are the interaction states of an Element, in order of
+// precedence. When an Element is in more than one state, the Style and Border
+// of the first of those states having one are used. Pressed and invalid
+// states take precedence over the focused state so that feedback on clicks
+// and validation errors is always visible, and the focused state takes
+// precedence over the persistent selected and checked states so that keyboard
+// navigation is always visible.
+var motifStates = []motifState{
+	{(*Element).Disabled, types.Motif.DisabledStyle, types.Motif.DisabledBorder},
+	{(*Element).Pressed, types.Motif.PressedStyle, types.Motif.PressedBorder},
+	{(*Element).Invalid, types.Motif.InvalidStyle, types.Motif.InvalidBorder},
+	{(*Element).HasFocus, types.Motif.FocusedStyle, types.Motif.FocusedBorder},
+	{(*Element).Checked, types.Motif.CheckedStyle, types.Motif.CheckedBorder},
+	{(*Element).Selected, types.Motif.SelectedStyle, types.Motif.SelectedBorder},
+	{(*Element).Hovered, types.Motif.HoveredStyle, types.Motif.HoveredBorder},
+}
+
+// SetSelected sets whether the Element is selected, like a row in a list or
+// the active tab in a tab bar.
+func (e *Element) SetSelected(on bool) {
+	e.selected = on
+}
+
+// Selected returns true if the Element is selected.
+func (e *Element) Selected() bool {
+	return e.selected
+}
+
+// WithSelected sets whether the Element is selected and returns the Element.
+func (e *Element) WithSelected(on bool) types.Element {
+	e.SetSelected(on)
+	return e
+}
+
+// SetChecked sets whether the Element is checked, like a checkbox.
+func (e *Element) SetChecked(on bool) {
+	e.checked = on
+}
+
+// Checked returns true if the Element is checked.
+func (e *Element) Checked() bool {
+	return e.checked
+}
+
+// WithChecked sets whether the Element is checked and returns the Element.
+func (e *Element) WithChecked(on bool) types.Element {
+	e.SetChecked(on)
+	return e
+}
+
+// SetInvalid sets whether the Element has an invalid value, like a form field
+// that failed validation.
+func (e *Element) SetInvalid(on bool) {
+	e.invalid = on
+}
+
+// Invalid returns true if the Element has an invalid value.
+func (e *Element) Invalid() bool {
+	return e.invalid
+}
+
+// WithInvalid sets whether the Element has an invalid value and returns the
+// Element.
+func (e *Element) WithInvalid(on bool) types.Element {
+	e.SetInvalid(on)
+	return e
+}
@@ -1,13 +1,11 @@
 package element
 
 import (
-	"context"
 	"image/color"
 
-	"github.com/jaypipes/gt/core"
-	gtlog "github.com/jaypipes/gt/core/log"
 	"github.com/jaypipes/gt/core/motif"
 	"github.com/jaypipes/gt/core/style"
+	"github.com/jaypipes/gt/core/theme"
 	"github.com/jaypipes/gt/types"
 )
 
@@ -27,9 +25,29 @@ func (e *Element) WithThemeClass(class types.ThemeClass) types.Element {
 	return e
 }
 
-// Theme returns the Element's Theme, if any.
+// Theme returns the Element's Theme. If the Element has no Theme of its own,
+// the Theme of the nearest ancestor having a Theme is returned. If no ancestor
+// has a Theme, the Theme of the Element tree's ThemeProvider (the View and then
+// the Application) is returned, falling back to the default Theme.
 func (e *Element) Theme() types.Theme {
-	return e.theme
+	if e.theme != nil {
+		return e.theme
+	}
+	if parent, ok := e.Parent().(types.Themeable); ok {
+		return parent.Theme()
+	}
+	if e.themeProvider != nil {
+		if t := e.themeProvider.Theme(); t != nil {
+			return t
+		}
+	}
+	return theme.Default
+}
+
+// SetThemeProvider sets the thing that provides the Theme for the Element when
+// neither the Element nor any of its ancestors has a Theme.
+func (e *Element) SetThemeProvider(p types.ThemeProvider) {
+	e.themeProvider = p
 }
 
 // SetTheme sets the Element's Theme.
@@ -43,13 +61,53 @@ func (e *Element) WithTheme(t types.Theme) types.Element {
 	return e
 }
 
-// Motif returns the Element's Motif, if any.
+// Motif returns the Element's Motif, if any. If the Element has no Motif of
+// its own, the Motif that the Element's Theme has for the Element's
+// ThemeClass is returned.
 func (e *Element) Motif() types.Motif {
+	if e.motif != nil {
+		return e.motif
+	}
+	return e.themeMotif()
+}
+
+// themeMotif returns the Motif that the Element's Theme has for the Element's
+// ThemeClass, if any.
+func (e *Element) themeMotif() types.Motif {
+	if e.themeClass == types.ThemeClassNone {
+		return nil
+	}
 	t := e.Theme()
 	if t == nil {
-		return e.motif
+		return nil
 	}
-	return nil
+	return t.Motif(e.themeClass)
+}
+
+// themeStyle returns the Style that the Element's Theme has for the Element's
+// ThemeClass, if any.
+func (e *Element) themeStyle() types.Style {
+	if e.themeClass == types.ThemeClassNone {
+		return nil
+	}
+	t := e.Theme()
+	if t == nil {
+		return nil
+	}
+	return t.Style(e.themeClass)
+}
+
+// motifs returns the Element's own Motif followed by the Motif that the
+// Element's Theme has for the Element's ThemeClass, skipping any that are nil.
+func (e *Element) motifs() []types.Motif {
+	motifs := make([]types.Motif, 0, 2)
+	if e.motif != nil {
+		motifs = append(motifs, e.motif)
+	}
+	if tm := e.themeMotif(); tm != nil {
+		motifs = append(motifs, tm)
+	}
+	return motifs
 }
 
 // SetMotif sets the Element's Motif.
@@ -65,59 +123,73 @@ func (e *Element) WithMotif(m types.Motif) types.Element {
 
 // Unstyled returns true if the Element has no styling.
 func (e *Element) Unstyled() bool {
-	m := e.Motif()
-	if m == nil || m.Unstyled() {
-		return true
+	for _, m := range e.motifs() {
+		if !m.Unstyled() {
+			return false
+		}
+	}
+	if e.declaredStyle(nil, nil) != nil {
+		return false
 	}
-	return false
+	ts := e.themeStyle()
+	return ts == nil || ts.Unstyled()
+}
+
+// Style returns the Element's Style. If the Element is disabled, pressed,
+// invalid, focused, checked, selected or hovered over, returns the Style for
+// the first of those states that has one set. Otherwise, returns the Element's
+// normal Style.
+//
+// Each of these styles is looked up first in the Element's own Motif and then
+// in the Motif that the Element's Theme has for the Element's ThemeClass,
+// falling back to the Style that the Theme has for the Element's ThemeClass.
+// Any style properties declared for the Element by its Stylesheets are
+// applied on top of the resulting Style. Finally, each inherited property
+// (colors and text attributes) that the Style does not specify is taken from
+// the parent Element's Style.
+func (e *Element) Style() types.Style {
+	parent := e.parentStyle()
+	return style.Inherit(e.declaredStyle(e.stateStyle(), parent), parent)
 }
 
-// Style returns the Element's Style. If the Element has the focus, returns the
-// Element's FocusStyle, if set. If the mouse is currently hovering over the
-// Element, returns the Element's HoverStyle, if set. Otherwise, returns the
-// Element's normal Style or if not set, the nearest parent's Style.
-func (e *Element) Style() types.Style {
-	m := e.motif
-	if e.disabled && m != nil {
-		ds := m.DisabledStyle()
-		if ds != nil {
-			return ds
-		}
+// Specified returns true if the Element's own Style or its Stylesheets
+// explicitly set the supplied Property, rather than inheriting it.
+func (e *Element) Specified(p types.Property) bool {
+	s := e.declaredStyle(e.stateStyle(), e.parentStyle())
+	return s != nil && s.Specified(p)
+}
+
+// parentStyle returns the Style of the Element's parent Element, if any.
+func (e *Element) parentStyle() types.Style {
+	if parent, ok := e.Parent().(types.Element); ok {
+		return parent.Style()
 	}
-	if e.focused && m != nil {
-		fs := m.FocusedStyle()
-		if fs != nil {
-			return fs
+	return nil
+}
+
+// stateStyle returns the Element's Style for its current state, without any
+// style properties declared by its Stylesheets or inherited from its parent.
+func (e *Element) stateStyle() types.Style {
+	motifs := e.motifs()
+	for _, st := range motifStates {
+		if !st.active(e) {
+			continue
 		}
-	}
-	if e.hovered && m != nil {
-		hs := m.HoveredStyle()
-		if hs != nil {
-			return hs
+		for _, m := range motifs {
+			if s := st.style(m); s != nil {
+				return s
+			}
 		}
 	}
-	var s types.Style
-	if m != nil {
-		s = m.NormalStyle()
-	}
-	if s != nil && !s.Unstyled() {
-		return s
-	}
-
-	// If there is no style set, inherit from the nearest parent with non-empty
-	// style.
-	ctx := context.TODO()
-	parentNode := e.Parent()
-	parent, ok := parentNode.(types.Element)
-	if ok {
-		if !parent.Unstyled() {
-			gtlog.Debug(
-				ctx, "Element.Style[%s]: inheriting parent %s style",
-				e.Tag(), core.ID(parentNode),
-			)
-			return parent.Style()
+	for _, m := range motifs {
+		s := m.NormalStyle()
+		if s != nil && !s.Unstyled() {
+			return s
 		}
 	}
+	if ts := e.themeStyle(); ts != nil && !ts.Unstyled() {
+		return ts
+	}
 	return nil
 }
 
@@ -220,6 +292,102 @@ func (e *Element) WithHoveredStyle(style types.Style) types.Element {
 	return e
 }
 
+// PressedStyle returns the Element's Style when a mouse button is held down on
+// the Element.
+func (e *Element) PressedStyle() types.Style {
+	if e.motif == nil {
+		return nil
+	}
+	return e.motif.PressedStyle()
+}
+
+// SetPressedStyle sets the Element's Style when a mouse button is held down on
+// the Element.
+func (e *Element) SetPressedStyle(style types.Style) {
+	if e.motif == nil {
+		e.motif = motif.Empty()
+	}
+	e.motif.SetPressedStyle(style)
+}
+
+// WithPressedStyle sets the Element's Style when a mouse button is held down on
+// the Element and returns the Element.
+func (e *Element) WithPressedStyle(style types.Style) types.Element {
+	e.SetPressedStyle(style)
+	return e
+}
+
+// SelectedStyle returns the Element's Style when the Element is selected.
+func (e *Element) SelectedStyle() types.Style {
+	if e.motif == nil {
+		return nil
+	}
+	return e.motif.SelectedStyle()
+}
+
+// SetSelectedStyle sets the Element's Style when the Element is selected.
+func (e *Element) SetSelectedStyle(style types.Style) {
+	if e.motif == nil {
+		e.motif = motif.Empty()
+	}
+	e.motif.SetSelectedStyle(style)
+}
+
+// WithSelectedStyle sets the Element's Style when the Element is selected and
+// returns the Element.
+func (e *Element) WithSelectedStyle(style types.Style) types.Element {
+	e.SetSelectedStyle(style)
+	return e
+}
+
+// CheckedStyle returns the Element's Style when the Element is checked.
+func (e *Element) CheckedStyle() types.Style {
+	if e.motif == nil {
+		return nil
+	}
+	return e.motif.CheckedStyle()
+}
+
+// SetCheckedStyle sets the Element's Style when the Element is checked.
+func (e *Element) SetCheckedStyle(style types.Style) {
+	if e.motif == nil {
+		e.motif = motif.Empty()
+	}
+	e.motif.SetCheckedStyle(style)
+}
+
+// WithCheckedStyle sets the Element's Style when the Element is checked and
+// returns the Element.
+func (e *Element) WithCheckedStyle(style types.Style) types.Element {
+	e.SetCheckedStyle(style)
+	return e
+}
+
+// InvalidStyle returns the Element's Style when the Element has an invalid
+// value.
+func (e *Element) InvalidStyle() types.Style {
+	if e.motif == nil {
+		return nil
+	}
+	return e.motif.InvalidStyle()
+}
+
+// SetInvalidStyle sets the Element's Style when the Element has an invalid
+// value.
+func (e *Element) SetInvalidStyle(style types.Style) {
+	if e.motif == nil {
+		e.motif = motif.Empty()
+	}
+	e.motif.SetInvalidStyle(style)
+}
+
+// WithInvalidStyle sets the Element's Style when the Element has an invalid
+// value and returns the Element.
+func (e *Element) WithInvalidStyle(style types.Style) types.Element {
+	e.SetInvalidStyle(style)
+	return e
+}
+
 // Bold returns true if the Element is bolded.
 func (e *Element) Bold() bool {
 	s := e.Style()
@@ -231,11 +399,10 @@ func (e *Element) Bold() bool {
 
 // SetBold sets the Element's bold attribute.
 func (e *Element) SetBold(on bool) {
-	s := e.Style()
-	if s == nil {
+	if e.motif == nil {
 		e.motif = motif.Empty()
 	}
-	s = e.motif.NormalStyle()
+	s := e.motif.NormalStyle()
 	if s == nil {
 		s = style.Empty()
 	}
@@ -260,11 +427,10 @@ func (e *Element) Italic() bool {
 
 // SetItalic sets the Element's italic attribute.
 func (e *Element) SetItalic(on bool) {
-	s := e.Style()
-	if s == nil {
+	if e.motif == nil {
 		e.motif = motif.Empty()
 	}
-	s = e.motif.NormalStyle()
+	s := e.motif.NormalStyle()
 	if s == nil {
 		s = style.Empty()
 	}
@@ -289,11 +455,10 @@ func (e *Element) Dim() bool {
 
 // SetDim sets the Element's dim attribute.
 func (e *Element) SetDim(on bool) {
-	s := e.Style()
-	if s == nil {
+	if e.motif == nil {
 		e.motif = motif.Empty()
 	}
-	s = e.motif.NormalStyle()
+	s := e.motif.NormalStyle()
 	if s == nil {
 		s = style.Empty()
 	}
@@ -318,11 +483,10 @@ func (e *Element) Strikethrough() bool {
 
 // SetStrikethrough sets the Element's strikethrough attribute.
 func (e *Element) SetStrikethrough(on bool) {
-	s := e.Style()
-	if s == nil {
+	if e.motif == nil {
 		e.motif = motif.Empty()
 	}
-	s = e.motif.NormalStyle()
+	s := e.motif.NormalStyle()
 	if s == nil {
 		s = style.Empty()
 	}
@@ -348,11 +512,10 @@ func (e *Element) Blink() bool {
 
 // SetBlink sets the Element's blink attribute.
 func (e *Element) SetBlink(on bool) {
-	s := e.Style()
-	if s == nil {
+	if e.motif == nil {
 		e.motif = motif.Empty()
 	}
-	s = e.motif.NormalStyle()
+	s := e.motif.NormalStyle()
 	if s == nil {
 		s = style.Empty()
 	}
@@ -366,6 +529,35 @@ func (e *Element) WithBlink(on bool) types.Element {
 	return e
 }
 
+// Reverse returns true if the Element has its foreground and background
+// colors reversed.
+func (e *Element) Reverse() bool {
+	s := e.Style()
+	if s == nil {
+		return false
+	}
+	return s.Reverse()
+}
+
+// SetReverse sets the Element's reverse attribute.
+func (e *Element) SetReverse(on bool) {
+	if e.motif == nil {
+		e.motif = motif.Empty()
+	}
+	s := e.motif.NormalStyle()
+	if s == nil {
+		s = style.Empty()
+	}
+	s.SetReverse(on)
+	e.motif.SetNormalStyle(s)
+}
+
+// WithReverse sets the Element's reverse attribute and returns the Element
+func (e *Element) WithReverse(on bool) types.Element {
+	e.SetReverse(on)
+	return e
+}
+
 // Underline returns true if the Element is underlined.
 func (e *Element) Underline() bool {
 	s := e.Style()
@@ -386,11 +578,10 @@ func (e *Element) UnderlineStyle() types.UnderlineStyle {
 
 // SetUnderlineStyle sets the Element's underline style.
 func (e *Element) SetUnderlineStyle(us types.UnderlineStyle) {
-	s := e.Style()
-	if s == nil {
+	if e.motif == nil {
 		e.motif = motif.Empty()
 	}
-	s = e.motif.NormalStyle()
+	s := e.motif.NormalStyle()
 	if s == nil {
 		s = style.Empty()
 	}
@@ -418,11 +609,10 @@ func (e *Element) ForegroundColor() types.Color {
 
 // SetForegroundColor sets the Style's foreground color.
 func (e *Element) SetForegroundColor(color types.Color) {
-	s := e.Style()
-	if s == nil {
+	if e.motif == nil {
 		e.motif = motif.Empty()
 	}
-	s = e.motif.NormalStyle()
+	s := e.motif.NormalStyle()
 	if s == nil {
 		s = style.Empty()
 	}
@@ -448,11 +638,10 @@ func (e *Element) BackgroundColor() types.Color {
 
 // SetBackgroundColor sets the Style's background color.
 func (e *Element) SetBackgroundColor(color types.Color) {
-	s := e.Style()
-	if s == nil {
+	if e.motif == nil {
 		e.motif = motif.Empty()
 	}
-	s = e.motif.NormalStyle()
+	s := e.motif.NormalStyle()
 	if s == nil {
 		s = style.Empty()
 	}
@@ -478,11 +667,10 @@ func (e *Element) UnderlineColor() types.Color {
 
 // SetUnderlineColor sets the Style's underline color.
 func (e *Element) SetUnderlineColor(color types.Color) {
-	s := e.Style()
-	if s == nil {
+	if e.motif == nil {
 		e.motif = motif.Empty()
 	}
-	s = e.motif.NormalStyle()
+	s := e.motif.NormalStyle()
 	if s == nil {
 		s = style.Empty()
 	}
@@ -497,4 +685,50 @@ func (e *Element) WithUnderlineColor(color types.Color) types.Element {
 	return e
 }
 
+// Hyperlink returns the URL and ID of the Element's OSC 8 hyperlink, if any.
+// Hyperlinks are not inherited from the Element's parent.
+func (e *Element) Hyperlink() (string, string) {
+	s := e.Style()
+	if s == nil {
+		return "", ""
+	}
+	return s.Hyperlink()
+}
+
+// SetHyperlink sets the URL and optional ID of the Element's OSC 8
+// hyperlink. Terminals that support OSC 8 make the Element's text content
+// clickable.
+func (e *Element) SetHyperlink(url, id string) {
+	if e.motif == nil {
+		e.motif = motif.Empty()
+	}
+	s := e.motif.NormalStyle()
+	if s == nil {
+		s = style.Empty()
+	}
+	s.SetHyperlink(url, id)
+	e.motif.SetNormalStyle(s)
+}
+
+// unlinked returns the supplied Style without its OSC 8 hyperlink, if any.
+// It styles the padding cells and the cells added by alignment, so that only
+// the Element's text content is clickable.
+func unlinked(s types.Style) types.Style {
+	if s == nil {
+		return nil
+	}
+	if url, _ := s.Hyperlink(); url == "" {
+		return s
+	}
+	out := style.Clone(s)
+	out.SetHyperlink("", "")
+	return out
+}
+
+// WithHyperlink sets the Element's OSC 8 hyperlink and returns the Element.
+func (e *Element) WithHyperlink(url, id string) types.Element {
+	e.SetHyperlink(url, id)
+	return e
+}
+
 var _ types.Style = (*Element)(nil)
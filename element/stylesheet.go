@@ -0,0 +1,244 @@
+package element
+
+import (
+	"context"
+
+	"github.com/jaypipes/gt/core/style"
+	"github.com/jaypipes/gt/core/stylesheet"
+	"github.com/jaypipes/gt/types"
+)
+
+// layoutProperties are the Properties that are applied to the Element's box
+// when the Element's Stylesheets are cascaded.
+var layoutProperties = []types.Property{
+	types.PropertyPadding,
+	types.PropertyWidth,
+	types.PropertyHeight,
+	types.PropertyAlignment,
+	types.PropertyDisplay,
+}
+
+// Stylesheet returns the Element's Stylesheet, if any.
+func (e *Element) Stylesheet() types.Stylesheet {
+	return e.stylesheet
+}
+
+// SetStylesheet sets the Element's Stylesheet. The Stylesheet's Rules apply to
+// the Element and its descendants.
+func (e *Element) SetStylesheet(s types.Stylesheet) {
+	e.stylesheet = s
+}
+
+// WithStylesheet sets the Element's Stylesheet and returns the Element.
+func (e *Element) WithStylesheet(s types.Stylesheet) types.Element {
+	e.SetStylesheet(s)
+	return e
+}
+
+// SetStylesheetProvider sets the thing that provides the outermost
+// Stylesheet for the Element and its descendants.
+func (e *Element) SetStylesheetProvider(p types.StylesheetProvider) {
+	e.stylesheetProvider = p
+}
+
+// stylesheets returns the Stylesheets that apply to the Element, outermost
+// first: the Stylesheet of the Element tree's StylesheetProvider followed by
+// the Stylesheets of the Element's ancestors and the Element itself.
+func (e *Element) stylesheets() []types.Stylesheet {
+	var sheets []types.Stylesheet
+	if parent, ok := e.Parent().(*Element); ok {
+		sheets = parent.stylesheets()
+	} else if e.stylesheetProvider != nil {
+		if s := e.stylesheetProvider.Stylesheet(); s != nil {
+			sheets = append(sheets, s)
+		}
+	}
+	if e.stylesheet != nil {
+		sheets = append(sheets, e.stylesheet)
+	}
+	return sheets
+}
+
+// Cascade computes the Element's properties from the Rules of the
+// Stylesheets that apply to it, given the current state (focused, hovered,
+// disabled, pressed, etc) of the Element and its ancestors.
+//
+// Declared style properties and borders take precedence over the Element's
+// own Style and Border. Declared padding, size, alignment and display
+// properties replace the Element's own values, which are restored once no
+// matching Rule declares them. Any property may be declared as
+// [types.Inherit] or [types.Initial].
+func (e *Element) Cascade(ctx context.Context) {
+	e.declared = stylesheet.Cascade(e, e.stylesheets()...)
+	for _, p := range layoutProperties {
+		v, ok := e.declared[p]
+		if !ok {
+			if base, saved := e.undeclared[p]; saved {
+				e.setLayoutProperty(p, base)
+				delete(e.undeclared, p)
+			}
+			continue
+		}
+		if _, saved := e.undeclared[p]; !saved {
+			if e.undeclared == nil {
+				e.undeclared = map[types.Property]any{}
+			}
+			e.undeclared[p] = e.layoutProperty(p)
+		}
+		switch v {
+		case types.Inherit:
+			v = nil
+			if parent, ok := e.Parent().(*Element); ok {
+				v = parent.layoutProperty(p)
+			}
+		case types.Initial:
+			v = nil
+		}
+		if v == nil {
+			v = initialLayoutProperty(p)
+		}
+		e.setLayoutProperty(p, v)
+	}
+}
+
+// initialLayoutProperty returns the initial value of the supplied layout
+// Property.
+func initialLayoutProperty(p types.Property) any {
+	switch p {
+	case types.PropertyPadding:
+		return types.Padding{}
+	case types.PropertyAlignment:
+		return types.AlignmentAuto
+	case types.PropertyDisplay:
+		return types.DisplayInline
+	}
+	return nil
+}
+
+// layoutProperty returns the Element's current value for the supplied layout
+// Property.
+func (e *Element) layoutProperty(p types.Property) any {
+	switch p {
+	case types.PropertyPadding:
+		return e.Padding()
+	case types.PropertyWidth:
+		return e.WidthConstraint()
+	case types.PropertyHeight:
+		return e.HeightConstraint()
+	case types.PropertyAlignment:
+		return e.Alignment()
+	case types.PropertyDisplay:
+		return e.Display()
+	}
+	return nil
+}
+
+// setLayoutProperty sets the Element's value for the supplied layout
+// Property. Values of the wrong type are ignored. A nil width or height,
+// their initial value, removes the Element's constraint.
+func (e *Element) setLayoutProperty(p types.Property, v any) {
+	switch p {
+	case types.PropertyPadding:
+		if pad, ok := v.(types.Padding); ok {
+			e.Box.SetPadding(pad)
+		}
+	case types.PropertyWidth:
+		if c, ok := v.(types.DimensionConstraint); ok || v == nil {
+			e.Box.SetWidth(c)
+		}
+	case types.PropertyHeight:
+		if c, ok := v.(types.DimensionConstraint); ok || v == nil {
+			e.Box.SetHeight(c)
+		}
+	case types.PropertyAlignment:
+		if a, ok := v.(types.Alignment); ok {
+			e.Box.SetAlignment(a)
+		}
+	case types.PropertyDisplay:
+		if d, ok := v.(types.Display); ok {
+			e.Box.SetDisplay(d)
+		}
+	}
+}
+
+// declaredStyle returns the supplied Style with any style properties
+// declared for the Element by its Stylesheets applied. Properties declared as
+// [types.Inherit] are taken from the supplied parent Style.
+func (e *Element) declaredStyle(base, parent types.Style) types.Style {
+	var s *style.Style
+	for p, v := range e.declared {
+		if s == nil {
+			switch p {
+			case types.PropertyForegroundColor, types.PropertyBackgroundColor,
+				types.PropertyBold, types.PropertyItalic, types.PropertyDim,
+				types.PropertyUnderline, types.PropertyStrikethrough,
+				types.PropertyBlink, types.PropertyReverse,
+				types.PropertyHyperlink:
+				s = style.Clone(base)
+			default:
+				continue
+			}
+		}
+		switch v {
+		case types.Inherit:
+			style.Copy(s, parent, p)
+			continue
+		case types.Initial:
+			style.Copy(s, nil, p)
+			continue
+		}
+		switch p {
+		case types.PropertyForegroundColor:
+			c, _ := v.(types.Color)
+			s.SetForegroundColor(c)
+		case types.PropertyBackgroundColor:
+			c, _ := v.(types.Color)
+			s.SetBackgroundColor(c)
+		case types.PropertyBold:
+			on, _ := v.(bool)
+			s.SetBold(on)
+		case types.PropertyItalic:
+			on, _ := v.(bool)
+			s.SetItalic(on)
+		case types.PropertyDim:
+			on, _ := v.(bool)
+			s.SetDim(on)
+		case types.PropertyUnderline:
+			ul, _ := v.(types.UnderlineStyle)
+			s.SetUnderlineStyle(ul)
+		case types.PropertyStrikethrough:
+			on, _ := v.(bool)
+			s.SetStrikethrough(on)
+		case types.PropertyBlink:
+			on, _ := v.(bool)
+			s.SetBlink(on)
+		case types.PropertyReverse:
+			on, _ := v.(bool)
+			s.SetReverse(on)
+		case types.PropertyHyperlink:
+			l, _ := v.(types.Hyperlink)
+			s.SetHyperlink(l.URL, l.ID)
+		}
+	}
+	if s == nil {
+		return base
+	}
+	return s
+}
+
+// declaredBorder returns the Border declared for the Element by its
+// Stylesheets, if any.
+func (e *Element) declaredBorder() (types.Border, bool) {
+	v, ok := e.declared[types.PropertyBorder]
+	if !ok {
+		return nil, false
+	}
+	if v == types.Inherit {
+		if parent, ok := e.Parent().(types.Element); ok {
+			return parent.Border(), true
+		}
+		return nil, true
+	}
+	b, _ := v.(types.Border)
+	return b, true
+}
@@ -71,6 +71,7 @@ func New(
 	t.SetAlignment(types.AlignmentTopLeft)
 	t.SetWhitespace(types.WhitespacePreserve)
 	t.SetBorder(border.Normal())
+	t.SetThemeClass(types.ThemeClassInput)
 	// TextArea defaults to a width of 20 cells and a height of 2 lines, the
 	// same as the HTML element of the same name.
 	t.SetWidth(core.Fixed(DefaultWidth))
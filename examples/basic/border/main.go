@@ -0,0 +1,68 @@
+package main
+
+import (
+	"log"
+
+	"github.com/jaypipes/gt"
+	gtapp "github.com/jaypipes/gt/core/application"
+	"github.com/jaypipes/gt/element/div"
+	"github.com/jaypipes/gt/element/span"
+)
+
+type myApp struct {
+	*gt.Application
+}
+
+func init() {
+	// gt.RegisterBorder makes a Border available by name to gt.LookupBorder
+	// and to the "variant" and "sides" fields of theme files.
+	gt.RegisterBorder("ticket", func() gt.Border {
+		return gt.MustBorderFromSpec("╓─╖║ ║╙─╜")
+	})
+}
+
+func main() {
+	// create a new context.Context from environs variables
+	ctx := gt.ContextFromEnv()
+	// create a new myApp that wraps the gt.Application
+	app := myApp{gtapp.New(ctx)}
+
+	v := app.View(ctx, "main")
+
+	ticket, _ := gt.LookupBorder("ticket")
+	borders := []struct {
+		name   string
+		border gt.Border
+	}{
+		{"dashed", gt.DashedBorder()},
+		{"heavy-dashed", gt.HeavyDashedBorder()},
+		{"dotted", gt.DottedBorder()},
+		{"heavy-dotted", gt.HeavyDottedBorder()},
+		// gt.BorderFromSpec builds a Border from its glyphs listed in row
+		// order: the top-left corner, top edge and top-right corner, the
+		// left edge, an optional center and the right edge, then the
+		// bottom-left corner, bottom edge and bottom-right corner.
+		{"spec", gt.MustBorderFromSpec("┏━┓┃┃┗━┛")},
+		{"ticket", ticket},
+		// gt.BorderSides combines the top, right, bottom and left edges of
+		// different Borders. The corners join the edges that meet there.
+		{"sides", gt.BorderSides(
+			gt.ThickBorder(), gt.DashedBorder(),
+			gt.DoubleBorder(), gt.NormalBorder(),
+		)},
+	}
+	for _, b := range borders {
+		d := div.New(
+			ctx,
+			gt.WithBorder(b.border),
+			gt.WithSize(gt.FixedArea(16, 1)),
+			gt.WithDisplay(gt.DisplayInlineBlock),
+		)
+		d.AppendChild(span.New(ctx, gt.WithTextContent(b.name)))
+		v.AppendContent(d)
+	}
+
+	if err := app.Start(ctx); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/jaypipes/gt"
+)
+
+func main() {
+	aaa := flag.Bool("aaa", false, "check against WCAG level AAA")
+	fix := flag.Bool("fix", false, "adjust failing colors and check again")
+	flag.Parse()
+
+	// gt.ContrastAA and gt.ContrastAAA are the minimum contrast ratios WCAG 2
+	// requires between text and its background.
+	minimum := gt.ContrastAA
+	if *aaa {
+		minimum = gt.ContrastAAA
+	}
+
+	// gt.LintTheme walks the Style of each ThemeClass and every state but
+	// the disabled state of its Motif, reporting the color pairs below the
+	// minimum contrast ratio.
+	// gt.FixThemeContrast does the same, nudging the lightness of failing
+	// foreground colors until they pass.
+	for _, name := range gt.ThemeNames() {
+		dark, light, _ := gt.LookupTheme(name)
+		for variant, t := range map[string]gt.Theme{"dark": dark, "light": light} {
+			issues := gt.LintTheme(t, minimum)
+			if *fix {
+				gt.FixThemeContrast(t, minimum)
+				issues = gt.LintTheme(t, minimum)
+			}
+			fmt.Printf("%s-%s: %d issues\n", name, variant, len(issues))
+			for _, issue := range issues {
+				fmt.Printf("  %s\n", issue)
+			}
+		}
+	}
+}
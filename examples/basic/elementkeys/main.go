@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/jaypipes/gt"
+	gtapp "github.com/jaypipes/gt/core/application"
+	gtkeyshortcut "github.com/jaypipes/gt/core/keyshortcut"
+	gtdiv "github.com/jaypipes/gt/element/div"
+	gttextarea "github.com/jaypipes/gt/element/textarea"
+)
+
+const help = `
+Press Tab to move the focus between the list and the text area.
+
+While the list has the focus, j and k move the selection. Anywhere else, j
+and k are free for other uses, and the Application-level j shortcut fires.
+`
+
+var items = []string{"apples", "bananas", "cherries", "dates"}
+
+type myApp struct {
+	*gt.Application
+}
+
+func main() {
+	// create a new context.Context from environs variables
+	ctx := gt.ContextFromEnv()
+	// create a new myApp that wraps the gt.Application
+	app := myApp{gtapp.New(ctx)}
+
+	v := app.View(ctx, "main")
+	status := gtdiv.New(ctx, gt.WithTextContent("press a key"))
+	v.AppendContent(status)
+	v.AppendContent(gtdiv.New(ctx, gt.WithTextContent(help)))
+
+	selected := 0
+	list := gtdiv.New(
+		ctx,
+		gt.WithID("list"),
+		gt.WithFocusable(true),
+		gt.WithBorder(gt.RoundedBorder()),
+	)
+	render := func() {
+		text := ""
+		for x, item := range items {
+			marker := "  "
+			if x == selected {
+				marker = "> "
+			}
+			text += marker + item + "\n"
+		}
+		list.SetTextContent(text)
+	}
+	render()
+	move := func(delta int) gt.KeyShortcutCallback {
+		return func(ctx context.Context) {
+			selected = (selected + delta + len(items)) % len(items)
+			render()
+		}
+	}
+
+	// Element-level KeyShortcuts are only active while the focus is within
+	// the Element's subtree, and they win over View and Application
+	// bindings of the same key.
+	list.SetKeyShortcut(gtkeyshortcut.New(
+		ctx,
+		gtkeyshortcut.WithKey(gt.MustKey("j")),
+		gtkeyshortcut.WithDescription("down"),
+		gtkeyshortcut.WithCallback(move(1)),
+	))
+	list.SetKeyShortcut(gtkeyshortcut.New(
+		ctx,
+		gtkeyshortcut.WithKey(gt.MustKey("k")),
+		gtkeyshortcut.WithDescription("up"),
+		gtkeyshortcut.WithCallback(move(-1)),
+	))
+	v.AppendContent(list)
+
+	v.AppendContent(gttextarea.New(
+		ctx,
+		gt.WithID("input"),
+		gt.WithHeight(gt.Fixed(5)),
+	))
+
+	presses := 0
+	app.SetKeyShortcut(gtkeyshortcut.New(
+		ctx,
+		gtkeyshortcut.WithKey(gt.MustKey("j")),
+		gtkeyshortcut.WithDescription("count"),
+		gtkeyshortcut.WithCallback(func(ctx context.Context) {
+			presses++
+			status.SetTextContent(fmt.Sprintf("application j: %d", presses))
+		}),
+	))
+
+	if err := app.Start(ctx); err != nil {
+		log.Fatal(err)
+	}
+}
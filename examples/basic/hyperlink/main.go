@@ -0,0 +1,49 @@
+package main
+
+import (
+	"log"
+
+	"github.com/jaypipes/gt"
+	gtapp "github.com/jaypipes/gt/core/application"
+	"github.com/jaypipes/gt/element/div"
+	"github.com/jaypipes/gt/element/span"
+)
+
+type myApp struct {
+	*gt.Application
+}
+
+func main() {
+	// create a new context.Context from environs variables
+	ctx := gt.ContextFromEnv()
+	// create a new myApp that wraps the gt.Application
+	app := myApp{gtapp.New(ctx)}
+
+	v := app.View(ctx, "main")
+
+	// The `gt.WithHyperlink` modifier makes an Element's text content an OSC
+	// 8 hyperlink. Terminals that support OSC 8 let you click the text to
+	// open the URL. Other terminals display the text as usual. Only the
+	// Element's own text is linked: its padding and child Elements are not.
+	v.AppendContent(span.New(
+		ctx,
+		gt.WithTextContent("gt on GitHub"),
+		gt.WithHyperlink("https://github.com/jaypipes/gt", ""),
+	))
+
+	// To link only part of an Element's text content, wrap that part with
+	// `gt.Link` and honor the ANSI escape sequences in the text. Text sharing
+	// a hyperlink ID is treated as a single link by the terminal.
+	v.AppendContent(div.New(
+		ctx,
+		gt.WithANSIMode(gt.ANSIModeHonor),
+		gt.WithTextContent(
+			"See "+gt.Link("main.go", "file:///tmp/main.go", "main")+
+				" and the "+gt.Link("Go docs", "https://go.dev/doc/", "")+".",
+		),
+	))
+
+	if err := app.Start(ctx); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/jaypipes/gt"
+	gtapp "github.com/jaypipes/gt/core/application"
+	gtbutton "github.com/jaypipes/gt/element/button"
+	gtdiv "github.com/jaypipes/gt/element/div"
+)
+
+// defaultKeymap is the keymap file with the application's default bindings.
+//
+//go:embed keymap.yaml
+var defaultKeymap []byte
+
+const usage = `
+Pass the path to a JSON, YAML or TOML keymap file to override the default
+bindings, e.g.:
+
+  application:
+    ctrl+r: ""      # unbind the default
+    ctrl+t: top
+`
+
+type myApp struct {
+	*gt.Application
+}
+
+func main() {
+	// create a new context.Context from environs variables
+	ctx := gt.ContextFromEnv()
+	// create a new myApp that wraps the gt.Application
+	app := myApp{gtapp.New(ctx)}
+
+	v := app.View(ctx, "main")
+	status := gtdiv.New(ctx, gt.WithTextContent("press a key"))
+	v.AppendContent(status)
+	v.AppendContent(gtbutton.New(
+		ctx,
+		gt.WithID("button"),
+		gt.WithTextContent("tab to me"),
+	))
+	v.AppendContent(gtdiv.New(ctx, gt.WithTextContent(usage)))
+
+	setStatus := func(msg string) gt.ActionCallback {
+		return func(ctx context.Context, target any) {
+			status.SetTextContent(fmt.Sprintf("%s (target: %T)", msg, target))
+		}
+	}
+
+	// Actions are registered by name, with a description and a callback. The
+	// callback's target is the Application, View or Element whose scope the
+	// key was bound in.
+	for _, a := range []gt.Action{
+		gt.NewAction(
+			"reset",
+			gt.WithActionDescription("Reset the status"),
+			gt.WithActionCallback(setStatus("reset")),
+		),
+		gt.NewAction(
+			"top",
+			gt.WithActionDescription("Go to the top"),
+			gt.WithActionCallback(setStatus("went to the top")),
+		),
+		gt.NewAction(
+			"help",
+			gt.WithActionDescription("List the active key bindings"),
+			gt.WithActionCallback(func(ctx context.Context, target any) {
+				// KeyBindings lists every active binding, in the order
+				// key presses are offered to them, along with whether
+				// each one wins or is shadowed by another.
+				lines := []string{}
+				for _, b := range app.KeyBindings() {
+					line := b.String()
+					if !b.Wins() {
+						line += " [shadowed by " + b.ShadowedBy.String() + "]"
+					}
+					lines = append(lines, line)
+				}
+				status.SetTextContent(strings.Join(lines, "\n"))
+			}),
+		),
+		gt.NewAction(
+			"clear",
+			gt.WithActionDescription("Clear the screen"),
+			gt.WithActionCallback(setStatus("cleared")),
+		),
+		gt.NewAction(
+			"press",
+			gt.WithActionDescription("Press the focused button"),
+			gt.WithActionCallback(setStatus("pressed")),
+		),
+	} {
+		app.RegisterAction(a)
+	}
+
+	km, err := gt.LoadKeymap(
+		bytes.NewReader(defaultKeymap), gt.KeymapFormatYAML,
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Bindings in the user's keymap file replace the defaults.
+	if len(os.Args) > 1 {
+		user, err := gt.LoadKeymapFile(os.Args[1])
+		if err != nil {
+			log.Fatal(err)
+		}
+		km = gt.MergeKeymaps(km, user)
+	}
+	for _, c := range gt.CheckKeymap(km, app) {
+		log.Fatal(c)
+	}
+	app.SetKeymap(ctx, km)
+
+	if err := app.Start(ctx); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -87,9 +87,14 @@ func main() {
 	)
 	d.SetTextContent(content(d))
 
-	// Use the gt.NewKey function to return a gt.Key object that you can use to
-	// compare to the Key you receive from the gt.KeyPressEvent
-	altR := gt.NewKey("alt+r")
+	// Use the gt.ParseKey function to return a gt.Key object that you can use
+	// to compare to the Key you receive from the gt.KeyPressEvent. It returns
+	// an error for typos like "alt+shfit+r". Emacs-style notation like "M-r"
+	// and the meta, super and hyper modifiers are also understood.
+	altR, err := gt.ParseKey("alt+r")
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	// You can take some action when a key is pressed. Use the OnKeyPress
 	// method to add a callback that will execute when any key is pressed. This
@@ -103,8 +108,9 @@ func main() {
 			// point for the trapped key. This is the same as Go's rune type
 			// and so can be directly typecast to a rune() below.
 			code := k.Code()
-			// gt.Key.String() returns a string representation of the gt.Key,
-			// e.g. "ctrl+a" or "backspace" or "alt+pgup"
+			// gt.Key.String() returns the canonical string representation of
+			// the gt.Key, e.g. "ctrl+a" or "backspace" or "alt+pgup", which
+			// gt.ParseKey turns back into an equal gt.Key.
 			str := k.String()
 			// gt.Key.Modifiers() returns the gt.KeyModifiers for the Key.
 			// gt.KeyModifiers has a String() method returning a string
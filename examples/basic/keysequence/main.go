@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/jaypipes/gt"
+	gtapp "github.com/jaypipes/gt/core/application"
+	gtkeyshortcut "github.com/jaypipes/gt/core/keyshortcut"
+	gtdiv "github.com/jaypipes/gt/element/div"
+)
+
+const help = `
+g g:           go to the top
+ctrl+x ctrl+s: save
+space f f:     find a file
+g:             go (after the sequence timeout)
+Escape:        cancel a pending sequence
+Ctrl-C:        exit the app
+`
+
+type myApp struct {
+	*gt.Application
+}
+
+func main() {
+	// create a new context.Context from environs variables
+	ctx := gt.ContextFromEnv()
+	// create a new myApp that wraps the gt.Application
+	app := myApp{gtapp.New(ctx)}
+
+	v := app.View(ctx, "main")
+
+	status := gtdiv.New(ctx, gt.WithTextContent("press a key sequence"))
+	v.AppendContent(status)
+	v.AppendContent(gtdiv.New(ctx, gt.WithTextContent(help)))
+
+	shortcut := func(keys string, msg string) gt.KeyShortcut {
+		return gtkeyshortcut.New(
+			ctx,
+			// Space-separated key press combinations are a sequence that
+			// must be pressed in order.
+			gtkeyshortcut.WithKey(gt.NewKey(keys)),
+			gtkeyshortcut.WithCallback(func(ctx context.Context) {
+				status.SetTextContent(msg)
+			}),
+		)
+	}
+
+	// Sequences can be bound at the Application level...
+	app.SetKeyShortcut(shortcut("g g", "went to the top"))
+	// ...alongside a shorter binding that they start with. The shorter
+	// binding fires once the sequence timeout expires without another key.
+	app.SetKeyShortcut(shortcut("g", "went"))
+	// ...and at the View level.
+	v.SetKeyShortcut(shortcut("ctrl+x ctrl+s", "saved"))
+	v.SetKeyShortcut(shortcut("space f f", "found a file"))
+
+	// While a sequence is pending, the keys pressed so far are shown in the
+	// bottom right corner of the screen until the next key press or the
+	// timeout.
+	app.SetKeySequenceTimeout(750 * time.Millisecond)
+
+	if err := app.Start(ctx); err != nil {
+		log.Fatal(err)
+	}
+}
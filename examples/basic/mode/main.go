@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/jaypipes/gt"
+	"github.com/jaypipes/gt/component/modeindicator"
+	gtapp "github.com/jaypipes/gt/core/application"
+	gtdiv "github.com/jaypipes/gt/element/div"
+	gttextarea "github.com/jaypipes/gt/element/textarea"
+)
+
+const help = `
+The Application starts in NORMAL mode, where:
+
+  i  switches to INSERT mode
+  :  switches to COMMAND mode
+
+In INSERT and COMMAND mode, ctrl+] returns to NORMAL mode.
+
+Press Tab to focus the text area. While it intercepts key presses, the
+Application switches to INSERT mode automatically, returning to the previous
+mode when Escape gives the focus back.
+`
+
+type myApp struct {
+	*gt.Application
+}
+
+func main() {
+	// create a new context.Context from environs variables
+	ctx := gt.ContextFromEnv()
+	// create a new myApp that wraps the gt.Application
+	app := myApp{gtapp.New(ctx)}
+
+	v := app.View(ctx, "main")
+	status := gtdiv.New(ctx, gt.WithTextContent("mode changes appear here"))
+	v.AppendContent(status)
+	v.AppendContent(gtdiv.New(ctx, gt.WithTextContent(help)))
+	v.AppendContent(gttextarea.New(
+		ctx,
+		gt.WithID("input"),
+		gt.WithHeight(gt.Fixed(5)),
+	))
+	// modeindicator.ModeIndicator shows the name of the current mode.
+	v.AppendContent(modeindicator.New(ctx, gt.WithID("mode")))
+
+	for _, name := range []string{"normal", "insert", "command"} {
+		app.RegisterAction(gt.NewAction(
+			name,
+			gt.WithActionDescription("switch to "+name+" mode"),
+			gt.WithActionCallback(func(ctx context.Context, target any) {
+				if err := app.SetMode(ctx, name); err != nil {
+					log.Fatal(err)
+				}
+			}),
+		))
+	}
+
+	// Each Mode has its own Keymap, whose bindings win over those of the
+	// Application's Keymap while the Mode is current.
+	back := gt.NewKeymap(gt.WithKeyBinding(
+		gt.ApplicationScope(), gt.MustKey("ctrl+]"), "normal",
+	))
+	app.RegisterMode(ctx, gt.NewMode("normal", gt.WithModeKeymap(gt.NewKeymap(
+		gt.WithKeyBinding(gt.ApplicationScope(), gt.MustKey("i"), "insert"),
+		gt.WithKeyBinding(gt.ApplicationScope(), gt.MustKey(":"), "command"),
+	))))
+	// An insert-like Mode is switched to automatically while a text input
+	// intercepts key presses.
+	app.RegisterMode(ctx, gt.NewMode(
+		"insert", gt.WithModeInsert(true), gt.WithModeKeymap(back),
+	))
+	app.RegisterMode(ctx, gt.NewMode("command", gt.WithModeKeymap(back)))
+
+	app.OnModeChange(func(ctx context.Context, from, to gt.Mode) {
+		if from != nil && to != nil {
+			status.SetTextContent(from.Name() + " -> " + to.Name())
+		}
+	})
+	if err := app.SetMode(ctx, "normal"); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := app.Start(ctx); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -19,6 +19,8 @@ Click on an element to see the element's focus style.
 
 Hover over an element to see the element's hover style.
 
+Hold the mouse button down on an element to see the element's pressed style.
+
 Exit with 'Ctrl+C'
 `
 )
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/jaypipes/gt"
+	gtapp "github.com/jaypipes/gt/core/application"
+	gtkey "github.com/jaypipes/gt/core/key"
+	gtkeyshortcut "github.com/jaypipes/gt/core/keyshortcut"
+	gtbutton "github.com/jaypipes/gt/element/button"
+	gttextarea "github.com/jaypipes/gt/element/textarea"
+)
+
+type myApp struct {
+	*gt.Application
+}
+
+func main() {
+	// create a new context.Context from environs variables. The GT_THEME
+	// environs variable names the registered Theme to use, for example
+	// "gruvbox" or "catppuccin-light". Alternately, pass the name of a
+	// registered Theme or the path to a terminal color scheme file as the
+	// first argument.
+	ctx := gt.ContextFromEnv()
+	// create a new myApp that wraps the gt.Application
+	app := myApp{gtapp.New(ctx)}
+	app.EnableMouse()
+
+	if len(os.Args) > 1 {
+		arg := os.Args[1]
+		if _, err := os.Stat(arg); err == nil {
+			// gt.LoadPaletteFile imports a base16/base24 YAML scheme,
+			// iTerm2 .itermcolors file or Alacritty/kitty config file,
+			// so the Application matches the user's terminal colors.
+			p, err := gt.LoadPaletteFile(arg)
+			if err != nil {
+				log.Fatal(err)
+			}
+			app.SetTheme(gt.ThemeFromScheme(p.Scheme()))
+		} else {
+			// gt.WithThemeName overrides the Theme named in GT_THEME.
+			ctx = gt.WithThemeName(arg)(ctx)
+		}
+	}
+
+	v := app.View(ctx, "main")
+
+	// The dark or light variant of the named Theme is chosen to match the
+	// terminal's background when the Application starts.
+	for _, name := range gt.ThemeNames() {
+		v.AppendContent(gt.NewSpan(ctx, gt.WithTextContent(name+" ")))
+	}
+
+	ta := gttextarea.New(ctx, gt.WithID("input"))
+	v.AppendContent(ta)
+
+	primary := gtbutton.New(
+		ctx,
+		gt.WithID("primary"),
+		gt.WithTextContent("primary"),
+	)
+	v.AppendContent(primary)
+
+	secondary := gtbutton.New(
+		ctx,
+		gt.WithID("secondary"),
+		gt.WithTextContent("secondary"),
+		gt.WithThemeClass(gt.ThemeClassSecondary),
+	)
+	v.AppendContent(secondary)
+
+	// Pressing Ctrl+T switches to the next registered Theme. SetTheme
+	// relayouts and redraws all Views while the Application is running.
+	names := gt.ThemeNames()
+	next := 0
+	app.SetKeyShortcut(gtkeyshortcut.New(
+		ctx,
+		gtkeyshortcut.WithKey(gtkey.New("ctrl+t")),
+		gtkeyshortcut.WithCallback(func(ctx context.Context) {
+			app.SetTheme(gt.SelectTheme(names[next], app.DarkBackground()))
+			next = (next + 1) % len(names)
+		}),
+	))
+
+	if err := app.Start(ctx); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jaypipes/gt"
+	gtapp "github.com/jaypipes/gt/core/application"
+	gtdiv "github.com/jaypipes/gt/element/div"
+)
+
+const help = `
+Record a session of key presses, then replay it:
+
+  GT_RECORD=session.jsonl go run ./examples/basic/replay
+  GT_REPLAY=session.jsonl go run ./examples/basic/replay
+
+GT_REPLAY_SPEED=2 replays twice as fast and 0 replays without delays.
+GT_REPLAY_STEP=1 replays one event for every key you press.
+
+Pass -headless to replay without a terminal and print the final screen.
+
+Ctrl-C: exit the app
+`
+
+func main() {
+	headless := flag.Bool(
+		"headless", false,
+		"replay GT_REPLAY on an in-memory screen and print the final screen",
+	)
+	width := flag.Int(
+		"width", 0,
+		"width of the headless screen, defaulting to the recorded width",
+	)
+	height := flag.Int(
+		"height", 0,
+		"height of the headless screen, defaulting to the recorded height",
+	)
+	flag.Parse()
+
+	// create a new context.Context from environs variables
+	ctx := gt.ContextFromEnv()
+
+	var app *gt.Application
+	var screen *gt.HeadlessScreen
+	if *headless {
+		if gt.ReplayPath(ctx) == "" {
+			log.Fatal("-headless requires GT_REPLAY to be set")
+		}
+		// gt.SessionSize returns the size of the screen the session was
+		// recorded on.
+		events, err := gt.LoadSessionFile(gt.ReplayPath(ctx))
+		if err != nil {
+			log.Fatal(err)
+		}
+		w, h, ok := gt.SessionSize(events)
+		if !ok {
+			w, h = 80, 24
+		}
+		if *width > 0 {
+			w = *width
+		}
+		if *height > 0 {
+			h = *height
+		}
+		// gt.NewHeadlessScreen draws to an in-memory terminal, so the
+		// replayed session can be inspected without a real one. Exit the
+		// Application once every recorded event has been replayed.
+		s, err := gt.NewHeadlessScreen(w, h)
+		if err != nil {
+			log.Fatal(err)
+		}
+		screen = s
+		ctx = gt.WithReplayExit(true)(ctx)
+		app = gtapp.NewWithScreen(ctx, s)
+	} else {
+		app = gtapp.New(ctx)
+	}
+
+	v := app.View(ctx, "main")
+	v.AppendContent(gtdiv.New(ctx, gt.WithTextContent(help)))
+
+	var input strings.Builder
+	d := gtdiv.New(ctx, gt.WithTextContent("typed: "))
+	d.OnKeyPress(
+		func(ctx context.Context, ev gt.KeyPressEvent) bool {
+			if k := ev.Key(); k.Printable() {
+				input.WriteRune(rune(k.Code()))
+				d.SetTextContent("typed: " + input.String())
+				return true
+			}
+			return false
+		},
+	)
+	v.AppendContent(d)
+
+	if err := app.Start(ctx); err != nil {
+		log.Fatal(err)
+	}
+
+	if screen != nil {
+		fmt.Println(strings.Join(screen.Text(), "\n"))
+	}
+}
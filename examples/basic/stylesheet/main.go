@@ -0,0 +1,85 @@
+package main
+
+import (
+	"log"
+
+	"github.com/jaypipes/gt"
+	gtapp "github.com/jaypipes/gt/core/application"
+	"github.com/jaypipes/gt/core/palette"
+	ss "github.com/jaypipes/gt/core/stylesheet"
+	gtbutton "github.com/jaypipes/gt/element/button"
+	gtdiv "github.com/jaypipes/gt/element/div"
+	gtspan "github.com/jaypipes/gt/element/span"
+)
+
+type myApp struct {
+	*gt.Application
+}
+
+func main() {
+	// create a new context.Context from environs variables
+	ctx := gt.ContextFromEnv()
+	// create a new myApp that wraps the gt.Application
+	app := myApp{gtapp.New(ctx)}
+	app.EnableMouse()
+
+	// A gt.Stylesheet is an ordered set of rules. Each rule has a selector
+	// that matches Elements by class (`gt.button`), ID (`#sidebar`), state
+	// (`:focus`, `:hover`, `:disabled`) and ancestry (`#sidebar gt.span`),
+	// along with the properties to set on the matched Elements. When rules
+	// conflict, the rule with the most specific selector wins.
+	//
+	// Colors and text attributes are inherited: the spans in the sidebar only
+	// set their foreground color and bold, so they take the sidebar's
+	// background color. Any property can be declared with `ss.Inherit` or
+	// `ss.Initial` to take the parent's value or reset it.
+	app.SetStylesheet(gt.NewStylesheet(
+		gt.WithStylesheetRule(
+			"#sidebar",
+			ss.Border(gt.RoundedBorder()),
+			ss.Width(gt.Fixed(30)),
+			ss.Padding(gt.PadHorizontal(1)),
+			ss.Display(gt.DisplayInlineBlock),
+			ss.BackgroundColor(palette.Nord1),
+		),
+		gt.WithStylesheetRule(
+			"#sidebar gt.span",
+			ss.ForegroundColor(palette.Nord8),
+			ss.Bold(true),
+		),
+		gt.WithStylesheetRule(
+			"#sidebar #plain",
+			ss.Initial(gt.PropertyBackgroundColor),
+			ss.Initial(gt.PropertyBold),
+		),
+		gt.WithStylesheetRule(
+			"gt.button:hover",
+			ss.ForegroundColor(palette.Nord13),
+		),
+		gt.WithStylesheetRule(
+			"gt.button:focus",
+			ss.Border(gt.DoubleBorder()),
+		),
+	))
+
+	v := app.View(ctx, "main")
+
+	sidebar := gtdiv.New(ctx, gt.WithID("sidebar"))
+	sidebar.AppendChild(gtspan.New(ctx, gt.WithTextContent("styled by #sidebar gt.span")))
+	sidebar.AppendChild(gtspan.New(
+		ctx,
+		gt.WithID("plain"),
+		gt.WithTextContent("background and bold reset by #plain"),
+	))
+	v.AppendContent(sidebar)
+
+	v.AppendContent(gtbutton.New(
+		ctx,
+		gt.WithID("button"),
+		gt.WithTextContent("hover or focus me"),
+	))
+
+	if err := app.Start(ctx); err != nil {
+		log.Fatal(err)
+	}
+}
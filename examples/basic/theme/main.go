@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"log"
+
+	"github.com/jaypipes/gt"
+	gtapp "github.com/jaypipes/gt/core/application"
+	gtbutton "github.com/jaypipes/gt/element/button"
+	gttextarea "github.com/jaypipes/gt/element/textarea"
+)
+
+// themeFile is a theme file describing a palette, styles and borders along
+// with the Motif for each ThemeClass.
+//
+//go:embed theme.yaml
+var themeFile []byte
+
+type myApp struct {
+	*gt.Application
+}
+
+func main() {
+	// create a new context.Context from environs variables
+	ctx := gt.ContextFromEnv()
+	// create a new myApp that wraps the gt.Application
+	app := myApp{gtapp.New(ctx)}
+	app.EnableMouse()
+
+	// gt.LoadTheme builds a gt.Theme from a JSON, YAML or TOML theme file.
+	// Use gt.LoadThemeFile to load a theme file from disk, and
+	// gt.ExportThemeFile to write a gt.Theme to disk.
+	theme, err := gt.LoadTheme(bytes.NewReader(themeFile), gt.ThemeFormatYAML)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Setting the Theme on the Application styles every Element in every View
+	// according to the Element's ThemeClass.
+	app.SetTheme(theme)
+
+	v := app.View(ctx, "main")
+
+	ta := gttextarea.New(ctx, gt.WithID("input"))
+	v.AppendContent(ta)
+
+	b := gtbutton.New(
+		ctx,
+		gt.WithID("button"),
+		gt.WithTextContent("click me!"),
+	)
+	v.AppendContent(b)
+
+	if err := app.Start(ctx); err != nil {
+		log.Fatal(err)
+	}
+}
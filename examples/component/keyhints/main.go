@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/jaypipes/gt"
+	"github.com/jaypipes/gt/component/keyhints"
+	gtapp "github.com/jaypipes/gt/core/application"
+	gtkeyshortcut "github.com/jaypipes/gt/core/keyshortcut"
+	gtdiv "github.com/jaypipes/gt/element/div"
+	gttextarea "github.com/jaypipes/gt/element/textarea"
+)
+
+const help = `
+Press ? or F1 to toggle the key binding help overlay, and Escape to close it.
+
+Press Tab to focus the text area. While it has the focus, the footer below
+changes to show the key that gives the focus back.
+`
+
+type myApp struct {
+	*gt.Application
+}
+
+func main() {
+	// create a new context.Context from environs variables
+	ctx := gt.ContextFromEnv()
+	// create a new myApp that wraps the gt.Application
+	app := myApp{gtapp.New(ctx)}
+
+	v := app.View(ctx, "main")
+	status := gtdiv.New(ctx, gt.WithTextContent("press a key"))
+	v.AppendContent(status)
+	v.AppendContent(gtdiv.New(ctx, gt.WithTextContent(help)))
+	v.AppendContent(gttextarea.New(
+		ctx,
+		gt.WithID("input"),
+		gt.WithHeight(gt.Fixed(5)),
+	))
+
+	shortcut := func(keys, desc string) gt.KeyShortcut {
+		return gtkeyshortcut.New(
+			ctx,
+			gtkeyshortcut.WithKey(gt.MustKey(keys)),
+			// The description is shown in the help overlay and the footer.
+			gtkeyshortcut.WithDescription(desc),
+			gtkeyshortcut.WithCallback(func(ctx context.Context) {
+				status.SetTextContent(desc)
+			}),
+		)
+	}
+	app.SetKeyShortcut(shortcut("ctrl+s", "save"))
+	app.SetKeyShortcut(shortcut("ctrl+o", "open"))
+	v.SetKeyShortcut(shortcut("ctrl+n", "new"))
+	v.SetKeyShortcut(shortcut("g g", "top"))
+
+	// keyhints.KeyHints is a one line footer listing the keys that currently
+	// apply, most specific first.
+	v.AppendContent(keyhints.New(ctx, gt.WithID("footer")))
+
+	if err := app.Start(ctx); err != nil {
+		log.Fatal(err)
+	}
+}
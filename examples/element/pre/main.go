@@ -0,0 +1,55 @@
+package main
+
+import (
+	"log"
+
+	"github.com/jaypipes/gt"
+	gtapp "github.com/jaypipes/gt/core/application"
+	gtpre "github.com/jaypipes/gt/element/pre"
+)
+
+// gitLog is the sort of colored output you would get from running
+// `git log --oneline --graph --color=always`.
+const gitLog = "\x1b[31m*\x1b[m \x1b[33m6d97dd3\x1b[m\x1b[33m (\x1b[m\x1b[1;36mHEAD -> \x1b[m\x1b[1;32mmain\x1b[m\x1b[33m)\x1b[m Add pre element example\n" +
+	"\x1b[31m*\x1b[m \x1b[33m1a2b3c4\x1b[m Parse \x1b[4mSGR\x1b[24m sequences\n" +
+	"\x1b[31m*\x1b[m \x1b[33m5d6e7f8\x1b[m \x1b[38;5;208m256-color\x1b[m and \x1b[38;2;136;192;208mtruecolor\x1b[m codes"
+
+type myApp struct {
+	*gt.Application
+}
+
+func main() {
+	// create a new context.Context from environs variables
+	ctx := gt.ContextFromEnv()
+	// create a new myApp that wraps the gt.Application
+	app := myApp{gtapp.New(ctx)}
+
+	v := app.View(ctx, "main")
+
+	// gt.Pre is a block element that preserves whitespace, similar to an HTML
+	// <pre> element. By default, any ANSI escape sequences in its text content
+	// are written verbatim. Use the `gt.WithANSIMode` modifier with
+	// `gt.ANSIModeHonor` to render the colors and attributes in the output of
+	// commands like `git` or `kubectl`.
+	honored := gtpre.New(
+		ctx,
+		gt.WithID("honored"),
+		gt.WithANSIMode(gt.ANSIModeHonor),
+		gt.WithTextContent(gitLog),
+	)
+	v.AppendContent(honored)
+
+	// Use `gt.ANSIModeStrip` to remove the escape sequences and display only
+	// the plain text.
+	stripped := gtpre.New(
+		ctx,
+		gt.WithID("stripped"),
+		gt.WithANSIMode(gt.ANSIModeStrip),
+		gt.WithTextContent(gitLog),
+	)
+	v.AppendContent(stripped)
+
+	if err := app.Start(ctx); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -58,9 +58,9 @@ func main() {
 		gt.WithTextContent(userInput),
 		// You can style your gt.TextArea like any other gt.Element.
 		gt.WithStyle(normalStyle),
-		gt.WithHoverStyle(hoverStyle),
-		gt.WithFocusBorder(focusBorder),
-		gt.WithHoverBorder(hoverBorder),
+		gt.WithHoveredStyle(hoverStyle),
+		gt.WithFocusedBorder(focusBorder),
+		gt.WithHoveredBorder(hoverBorder),
 		// Placeholder text is displayed in the absence of user-provided text
 		// input and is hidden when focus is placed on the TextArea.
 		gttextarea.WithPlaceholder(placeholder),
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"log"
+
+	"github.com/jaypipes/gt"
+	gtapp "github.com/jaypipes/gt/core/application"
+	"github.com/jaypipes/gt/element/div"
+	"github.com/jaypipes/gt/element/span"
+)
+
+type myApp struct {
+	*gt.Application
+}
+
+func main() {
+	// create a new context.Context from environs variables
+	ctx := gt.ContextFromEnv()
+	// create a new myApp that wraps the gt.Application
+	app := myApp{gtapp.New(ctx)}
+
+	v := app.View(ctx, "main")
+
+	// gt.WithBorderCollapse makes the bordered children of the table share a
+	// border line with their adjacent bordered siblings instead of drawing
+	// doubled lines (││). Where the shared lines meet, the box drawing
+	// characters are joined into junctions like ┬, ┼ and ┤, including where
+	// light, heavy and double borders meet.
+	table := div.New(ctx, gt.WithBorderCollapse(true))
+	rows := [][]gt.Border{
+		{gt.NormalBorder(), gt.NormalBorder(), gt.NormalBorder()},
+		{gt.NormalBorder(), gt.ThickBorder(), gt.NormalBorder()},
+		{gt.DoubleBorder(), gt.DoubleBorder(), gt.NormalBorder()},
+	}
+	for y, row := range rows {
+		for x, border := range row {
+			display := gt.DisplayInlineBlock
+			if x == 0 {
+				display = gt.DisplayBlock
+			}
+			cell := div.New(
+				ctx,
+				gt.WithBorder(border),
+				gt.WithSize(gt.FixedArea(12, 1)),
+				gt.WithDisplay(display),
+			)
+			cell.AppendChild(span.New(
+				ctx,
+				gt.WithTextContent(string(rune('A'+y))+string(rune('1'+x))),
+			))
+			table.AppendChild(cell)
+		}
+	}
+	v.AppendContent(table)
+
+	if err := app.Start(ctx); err != nil {
+		log.Fatal(err)
+	}
+}
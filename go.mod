@@ -3,12 +3,14 @@ module github.com/jaypipes/gt
 go 1.24.3
 
 require (
+	github.com/BurntSushi/toml v1.6.0
 	github.com/charmbracelet/x/ansi v0.11.6
 	github.com/gdamore/tcell/v3 v3.1.2
 	github.com/lucasb-eyer/go-colorful v1.3.0
 	github.com/mitchellh/go-wordwrap v1.0.1
 	github.com/muesli/termenv v0.16.0
 	github.com/samber/lo v1.52.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -0,0 +1,42 @@
+package types
+
+import "context"
+
+// ActionCallback is executed when an Action is triggered. The target is the
+// thing whose key binding triggered the Action: the Application, the active
+// View or the focused Element.
+type ActionCallback func(ctx context.Context, target any)
+
+// Action is a named operation, such as "save" or "quit", that a Keymap binds
+// keys to.
+type Action interface {
+	// Name returns the Action's unique name.
+	Name() string
+	// Description returns a short, human-readable description of what the
+	// Action does.
+	Description() string
+	// SetDescription sets the Action's description.
+	SetDescription(string)
+	// Callback returns the ActionCallback executed when the Action is
+	// triggered.
+	Callback() ActionCallback
+	// SetCallback sets the ActionCallback executed when the Action is
+	// triggered.
+	SetCallback(ActionCallback)
+}
+
+// ActionWithOption describes an optional varg parameter to [action.New] that
+// modifies the returned Action.
+type ActionWithOption func(Action)
+
+// ActionRegistry describes a thing that Actions can be registered with and
+// looked up by name.
+type ActionRegistry interface {
+	// RegisterAction registers the supplied Action, replacing any Action
+	// already registered with the same name.
+	RegisterAction(Action)
+	// Action returns the Action registered with the supplied name, or nil.
+	Action(name string) Action
+	// Actions returns all registered Actions, sorted by name.
+	Actions() []Action
+}
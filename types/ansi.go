@@ -0,0 +1,43 @@
+package types
+
+// ANSIMode describes how ANSI escape sequences embedded in an Element's text
+// content (for instance, the colored output of `git` or `kubectl`) are
+// handled when rendering.
+type ANSIMode uint8
+
+const (
+	// ANSIModeVerbatim indicates any ANSI escape sequences are written into
+	// cells as-is. This is the default.
+	ANSIModeVerbatim ANSIMode = iota
+	// ANSIModeStrip indicates that ANSI escape sequences are removed from the
+	// text content before rendering.
+	ANSIModeStrip
+	// ANSIModeHonor indicates that ANSI SGR (Select Graphic Rendition)
+	// sequences are parsed into styled cells. 16-color, 256-color and
+	// truecolor codes are supported, as are OSC 8 hyperlinks. Any other ANSI
+	// escape sequences are removed.
+	ANSIModeHonor
+)
+
+var (
+	ansiModeStrings = map[ANSIMode]string{
+		ANSIModeVerbatim: "verbatim",
+		ANSIModeStrip:    "strip",
+		ANSIModeHonor:    "honor",
+	}
+)
+
+func (m ANSIMode) String() string {
+	return ansiModeStrings[m]
+}
+
+// ANSIHandler describes something that handles ANSI escape sequences in its
+// text content.
+type ANSIHandler interface {
+	// ANSIMode returns how ANSI escape sequences in the text content are
+	// handled.
+	ANSIMode() ANSIMode
+	// SetANSIMode sets how ANSI escape sequences in the text content are
+	// handled.
+	SetANSIMode(ANSIMode)
+}
@@ -68,6 +68,23 @@ type Border interface {
 	// WithBackgroundColor sets the background color for all the Border's Cells
 	// and returns the Border.
 	WithBackgroundColor(Color) Border
+	// Title returns the BorderLabel embedded in the top edge of the Border, if
+	// any.
+	Title() BorderLabel
+	// SetTitle sets the BorderLabel embedded in the top edge of the Border.
+	SetTitle(BorderLabel)
+	// WithTitle sets the BorderLabel embedded in the top edge of the Border
+	// and returns the Border.
+	WithTitle(BorderLabel) Border
+	// Footer returns the BorderLabel embedded in the bottom edge of the
+	// Border, if any.
+	Footer() BorderLabel
+	// SetFooter sets the BorderLabel embedded in the bottom edge of the
+	// Border.
+	SetFooter(BorderLabel)
+	// WithFooter sets the BorderLabel embedded in the bottom edge of the
+	// Border and returns the Border.
+	WithFooter(BorderLabel) Border
 	// HorizontalSpace returns the number of cells the Border consumes.
 	HorizontalSpace() Dimension
 	// VerticalSpace returns the number of lines the Border consumes.
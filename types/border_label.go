@@ -0,0 +1,33 @@
+package types
+
+// BorderLabel describes a piece of text, such as a title or a footer, that is
+// embedded in the top or bottom edge line of a [Border].
+type BorderLabel interface {
+	// Content returns the BorderLabel's text.
+	Content() string
+	// SetContent sets the BorderLabel's text.
+	SetContent(string)
+	// Alignment returns the horizontal alignment of the BorderLabel within the
+	// Border's edge. Only AlignmentLeft, AlignmentCenter and AlignmentRight
+	// are considered. AlignmentAuto means left alignment.
+	Alignment() Alignment
+	// SetAlignment sets the horizontal alignment of the BorderLabel within the
+	// Border's edge.
+	SetAlignment(Alignment)
+	// Style returns the Style used when drawing the BorderLabel's text. If
+	// nil, the Style of the Border's edge is used.
+	Style() Style
+	// SetStyle sets the Style used when drawing the BorderLabel's text.
+	SetStyle(Style)
+	// Delimiters returns the strings drawn immediately to the left and right
+	// of the BorderLabel's text, e.g. "┤ " and " ├". Delimiters are drawn
+	// using the Style of the Border's edge.
+	Delimiters() (string, string)
+	// SetDelimiters sets the strings drawn immediately to the left and right
+	// of the BorderLabel's text.
+	SetDelimiters(string, string)
+}
+
+// BorderLabelWithOption describes an optional varg parameter to
+// [border.NewLabel] that modifies the returned BorderLabel.
+type BorderLabelWithOption func(BorderLabel)
@@ -6,4 +6,10 @@ type Borderable interface {
 	SetBorder(Border)
 	// Bounds returns the Border for the Borderable.
 	Border() Border
+	// SetBorderCollapse sets whether the bordered children of the Borderable
+	// share a border line with their adjacent bordered siblings.
+	SetBorderCollapse(bool)
+	// BorderCollapse returns whether the bordered children of the Borderable
+	// share a border line with their adjacent bordered siblings.
+	BorderCollapse() bool
 }
@@ -0,0 +1,33 @@
+package types
+
+// ColorProfile describes the range of colors a terminal is able to display.
+type ColorProfile uint8
+
+const (
+	// ColorProfileTrueColor indicates the terminal supports 24-bit RGB
+	// colors. This is the default.
+	ColorProfileTrueColor ColorProfile = iota
+	// ColorProfileANSI256 indicates the terminal supports the 256-color
+	// (8-bit) ANSI palette.
+	ColorProfileANSI256
+	// ColorProfileANSI indicates the terminal supports the 16-color (4-bit)
+	// ANSI palette.
+	ColorProfileANSI
+	// ColorProfileMonochrome indicates the terminal does not support colors,
+	// or that colors have been disabled, e.g. with the NO_COLOR environment
+	// variable. Text attributes like bold and reverse are still displayed.
+	ColorProfileMonochrome
+)
+
+var (
+	colorProfileStrings = map[ColorProfile]string{
+		ColorProfileTrueColor:  "truecolor",
+		ColorProfileANSI256:    "ansi256",
+		ColorProfileANSI:       "ansi",
+		ColorProfileMonochrome: "monochrome",
+	}
+)
+
+func (p ColorProfile) String() string {
+	return colorProfileStrings[p]
+}
@@ -15,7 +15,10 @@ import "context"
 // Element implements [types.Plottable] and [types.Renderable] which means that
 // every Element can draw itself onto a [types.Screen].
 type Element interface {
+	ANSIHandler
 	Borderable
+	Cascadable
+	Shadowed
 	FocusEventHandler
 	Identifiable
 	KeyPressEventHandler
@@ -46,6 +49,44 @@ type Element interface {
 	// Element.
 	WithDisabled(bool) Element
 
+	// Hovered returns true if the mouse is currently over the Element.
+	Hovered() bool
+	// Pressed returns true if a mouse button is currently held down on the
+	// Element.
+	Pressed() bool
+
+	// SetSelected sets whether the Element is selected, like a row in a list
+	// or the active tab in a tab bar.
+	SetSelected(bool)
+	// Selected returns true if the Element is selected.
+	Selected() bool
+	// WithSelected sets whether the Element is selected and returns the
+	// Element.
+	WithSelected(bool) Element
+	// SetChecked sets whether the Element is checked, like a checkbox.
+	SetChecked(bool)
+	// Checked returns true if the Element is checked.
+	Checked() bool
+	// WithChecked sets whether the Element is checked and returns the
+	// Element.
+	WithChecked(bool) Element
+	// SetInvalid sets whether the Element has an invalid value, like a form
+	// field that failed validation.
+	SetInvalid(bool)
+	// Invalid returns true if the Element has an invalid value.
+	Invalid() bool
+	// WithInvalid sets whether the Element has an invalid value and returns
+	// the Element.
+	WithInvalid(bool) Element
+
+	// Stylesheet returns the Element's Stylesheet, if any.
+	Stylesheet() Stylesheet
+	// SetStylesheet sets the Element's Stylesheet. The Stylesheet's Rules
+	// apply to the Element and its descendants.
+	SetStylesheet(Stylesheet)
+	// WithStylesheet sets the Element's Stylesheet and returns the Element.
+	WithStylesheet(Stylesheet) Element
+
 	// WithFocusable sets whether the Element can receive the focus and returns
 	// the Element.
 	WithFocusable(bool) Element
@@ -114,6 +155,42 @@ type Element interface {
 	// WithHoveredBorder sets the Border for the Element when the mouse is
 	// hovering over the Element and returns the Element.
 	WithHoveredBorder(Border) Element
+	// PressedBorder returns the Border for the Element when a mouse button is
+	// held down on the Element.
+	PressedBorder() Border
+	// SetPressedBorder sets the Border for the Element when a mouse button is
+	// held down on the Element.
+	SetPressedBorder(Border)
+	// WithPressedBorder sets the Border for the Element when a mouse button is
+	// held down on the Element and returns the Element.
+	WithPressedBorder(Border) Element
+	// SelectedBorder returns the Border for the Element when the Element is
+	// selected.
+	SelectedBorder() Border
+	// SetSelectedBorder sets the Border for the Element when the Element is
+	// selected.
+	SetSelectedBorder(Border)
+	// WithSelectedBorder sets the Border for the Element when the Element is
+	// selected and returns the Element.
+	WithSelectedBorder(Border) Element
+	// CheckedBorder returns the Border for the Element when the Element is
+	// checked.
+	CheckedBorder() Border
+	// SetCheckedBorder sets the Border for the Element when the Element is
+	// checked.
+	SetCheckedBorder(Border)
+	// WithCheckedBorder sets the Border for the Element when the Element is
+	// checked and returns the Element.
+	WithCheckedBorder(Border) Element
+	// InvalidBorder returns the Border for the Element when the Element has an
+	// invalid value.
+	InvalidBorder() Border
+	// SetInvalidBorder sets the Border for the Element when the Element has an
+	// invalid value.
+	SetInvalidBorder(Border)
+	// WithInvalidBorder sets the Border for the Element when the Element has an
+	// invalid value and returns the Element.
+	WithInvalidBorder(Border) Element
 	// SetBorderForegroundColor sets the Borderable's border foreground color
 	// (i.e the color of the border's cells underlying grapheme).
 	SetBorderForegroundColor(Color)
@@ -131,6 +208,48 @@ type Element interface {
 	// WithBorderBackgroundColor sets the Element's border background color (i.e
 	// the background color of the border cell's and returns the Element.
 	WithBorderBackgroundColor(Color) Element
+	// WithShadow sets the Element's drop shadow and returns the Element. The
+	// drop shadow is drawn outside the Element's bounds and is not considered
+	// when hit-testing.
+	WithShadow(Shadow) Element
+	// ForegroundGradient returns the Gradient used for the foreground color
+	// of the Element's text content, if any.
+	ForegroundGradient() Gradient
+	// SetForegroundGradient sets the Gradient used for the foreground color
+	// of the Element's text content.
+	SetForegroundGradient(Gradient)
+	// WithForegroundGradient sets the Gradient used for the foreground color
+	// of the Element's text content and returns the Element.
+	WithForegroundGradient(Gradient) Element
+	// BackgroundGradient returns the Gradient used to fill the Element's
+	// background, if any.
+	BackgroundGradient() Gradient
+	// SetBackgroundGradient sets the Gradient used to fill the Element's
+	// background inside its border.
+	SetBackgroundGradient(Gradient)
+	// WithBackgroundGradient sets the Gradient used to fill the Element's
+	// background inside its border and returns the Element.
+	WithBackgroundGradient(Gradient) Element
+	// BorderTitle returns the label embedded in the top edge of the Element's
+	// border, if any.
+	BorderTitle() BorderLabel
+	// SetBorderTitle sets the label embedded in the top edge of the Element's
+	// border. The title is kept when the Element's border changes on focus,
+	// hover or disable.
+	SetBorderTitle(BorderLabel)
+	// WithBorderTitle sets the label embedded in the top edge of the
+	// Element's border and returns the Element.
+	WithBorderTitle(BorderLabel) Element
+	// BorderFooter returns the label embedded in the bottom edge of the
+	// Element's border, if any.
+	BorderFooter() BorderLabel
+	// SetBorderFooter sets the label embedded in the bottom edge of the
+	// Element's border. The footer is kept when the Element's border changes
+	// on focus, hover or disable.
+	SetBorderFooter(BorderLabel)
+	// WithBorderFooter sets the label embedded in the bottom edge of the
+	// Element's border and returns the Element.
+	WithBorderFooter(BorderLabel) Element
 
 	// WithTheme sets the Element's theme class and returns the Element.
 	WithThemeClass(ThemeClass) Element
@@ -177,12 +296,47 @@ type Element interface {
 	// hovering over the Element and the Element does *NOT* have the focus.
 	// WithHoveredStyle returns the Element.
 	WithHoveredStyle(Style) Element
+	// PressedStyle returns the Element's Style when a mouse button is held down
+	// on the Element.
+	PressedStyle() Style
+	// SetPressedStyle sets the Element's style when a mouse button is held down
+	// on the Element.
+	SetPressedStyle(Style)
+	// WithPressedStyle sets the Element's style when a mouse button is held
+	// down on the Element and returns the Element.
+	WithPressedStyle(Style) Element
+	// SelectedStyle returns the Element's Style when the Element is selected.
+	SelectedStyle() Style
+	// SetSelectedStyle sets the Element's style when the Element is selected.
+	SetSelectedStyle(Style)
+	// WithSelectedStyle sets the Element's style when the Element is selected
+	// and returns the Element.
+	WithSelectedStyle(Style) Element
+	// CheckedStyle returns the Element's Style when the Element is checked.
+	CheckedStyle() Style
+	// SetCheckedStyle sets the Element's style when the Element is checked.
+	SetCheckedStyle(Style)
+	// WithCheckedStyle sets the Element's style when the Element is checked and
+	// returns the Element.
+	WithCheckedStyle(Style) Element
+	// InvalidStyle returns the Element's Style when the Element has an invalid
+	// value.
+	InvalidStyle() Style
+	// SetInvalidStyle sets the Element's style when the Element has an invalid
+	// value.
+	SetInvalidStyle(Style)
+	// WithInvalidStyle sets the Element's style when the Element has an invalid
+	// value and returns the Element.
+	WithInvalidStyle(Style) Element
 
 	// SetTextContent sets the Element's raw, unstyled text contents.
 	SetTextContent(string)
 	// WithTextContent sets the Element's raw, unstyled text contents and
 	// returns the Element.
 	WithTextContent(string) Element
+	// WithANSIMode sets how ANSI escape sequences in the Element's text
+	// content are handled and returns the Element.
+	WithANSIMode(ANSIMode) Element
 	// TextContent returns the Element's raw string contents.
 	TextContent() string
 	// TextContentWidth returns the width of the Element's raw string contents.
@@ -0,0 +1,108 @@
+package types
+
+// GradientDirection is the direction along which a linear Gradient's colors
+// change.
+type GradientDirection uint8
+
+const (
+	// GradientHorizontal indicates the Gradient's colors change from left to
+	// right. This is the default.
+	GradientHorizontal GradientDirection = iota
+	// GradientVertical indicates the Gradient's colors change from top to
+	// bottom.
+	GradientVertical
+)
+
+var (
+	gradientDirectionStrings = map[GradientDirection]string{
+		GradientHorizontal: "horizontal",
+		GradientVertical:   "vertical",
+	}
+)
+
+func (d GradientDirection) String() string {
+	return gradientDirectionStrings[d]
+}
+
+// ColorSpace is the color space in which colors are interpolated.
+type ColorSpace uint8
+
+const (
+	// ColorSpaceRGB interpolates colors in the sRGB color space. This is the
+	// default.
+	ColorSpaceRGB ColorSpace = iota
+	// ColorSpaceLinearRGB interpolates colors in the linear RGB color space.
+	ColorSpaceLinearRGB
+	// ColorSpaceHSV interpolates colors in the HSV color space.
+	ColorSpaceHSV
+	// ColorSpaceLab interpolates colors in the CIE L*a*b* color space.
+	ColorSpaceLab
+	// ColorSpaceLuv interpolates colors in the CIE L*u*v* color space.
+	ColorSpaceLuv
+	// ColorSpaceHCL interpolates colors in the HCL (polar L*a*b*) color
+	// space.
+	ColorSpaceHCL
+	// ColorSpaceOkLab interpolates colors in the OkLab color space.
+	ColorSpaceOkLab
+	// ColorSpaceOkLch interpolates colors in the OkLch (polar OkLab) color
+	// space.
+	ColorSpaceOkLch
+)
+
+var (
+	colorSpaceStrings = map[ColorSpace]string{
+		ColorSpaceRGB:       "rgb",
+		ColorSpaceLinearRGB: "linear-rgb",
+		ColorSpaceHSV:       "hsv",
+		ColorSpaceLab:       "lab",
+		ColorSpaceLuv:       "luv",
+		ColorSpaceHCL:       "hcl",
+		ColorSpaceOkLab:     "oklab",
+		ColorSpaceOkLch:     "oklch",
+	}
+)
+
+func (s ColorSpace) String() string {
+	return colorSpaceStrings[s]
+}
+
+// GradientStop is a color at a position along a Gradient. Position is in the
+// range [0, 1].
+type GradientStop struct {
+	// Position is the position of the stop along the Gradient, from 0 (the
+	// start) to 1 (the end).
+	Position float64
+	// Color is the color of the Gradient at the stop's position.
+	Color Color
+}
+
+// Gradient describes a linear, multi-stop color gradient.
+type Gradient interface {
+	// Direction returns the direction along which the Gradient's colors
+	// change.
+	Direction() GradientDirection
+	// SetDirection sets the direction along which the Gradient's colors
+	// change.
+	SetDirection(GradientDirection)
+	// ColorSpace returns the color space in which the Gradient's colors are
+	// interpolated.
+	ColorSpace() ColorSpace
+	// SetColorSpace sets the color space in which the Gradient's colors are
+	// interpolated.
+	SetColorSpace(ColorSpace)
+	// Stops returns the Gradient's color stops, ordered by position.
+	Stops() []GradientStop
+	// AddStop adds a color stop to the Gradient at the supplied position.
+	AddStop(float64, Color)
+	// At returns the interpolated color at the supplied position, from 0 (the
+	// start) to 1 (the end), along the Gradient. At returns nil if the
+	// Gradient has no stops.
+	At(float64) Color
+	// Along returns the color of the cell at the supplied point within the
+	// supplied area, according to the Gradient's direction.
+	Along(Point, Rectangle) Color
+}
+
+// GradientWithOption describes an optional varg parameter to [gradient.New]
+// that modifies the returned Gradient.
+type GradientWithOption func(Gradient)
@@ -25,16 +25,22 @@ func (c KeyCode) Printable() bool {
 	return c < KeyCodeNonPrintableStart
 }
 
-// Key describes a key press combination.
+// Key describes a key press combination or a sequence of key press
+// combinations, such as "g g" or "ctrl+x ctrl+s".
 type Key interface {
 	fmt.Stringer
 	KeyModifiable
-	// Code returns the Unicode code point for the Key.
+	// Code returns the Unicode code point for the Key. For a sequence, this is
+	// the code of the last key press combination.
 	Code() KeyCode
 	// Printable returns true if the Key can be directly printed to the Screen.
 	Printable() bool
 	// Equal returns true if the Key matches the supplied other Key.
 	Equal(Key) bool
+	// Sequence returns the key press combinations that must be pressed in
+	// order to actuate the Key. For a single key press combination, the
+	// returned slice contains only that combination.
+	Sequence() []Key
 }
 
 const (
@@ -0,0 +1,158 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// KeyBindingSource describes where a key binding was registered. The values
+// do not reflect the order in which the Application offers a key press to
+// them; KeyBindingProvider.KeyBindings returns the bindings in that order.
+type KeyBindingSource uint8
+
+const (
+	// KeyBindingSourceExit is an Application exit key.
+	KeyBindingSourceExit KeyBindingSource = iota
+	// KeyBindingSourceInterceptor is the escape key of the
+	// KeyPressEventHandler intercepting all key presses. While key presses
+	// are intercepted, the interceptor wins over every other binding.
+	KeyBindingSourceInterceptor
+	// KeyBindingSourceFocusNext is an Application key that moves the focus
+	// to the next focusable Element.
+	KeyBindingSourceFocusNext
+	// KeyBindingSourceApplication is an Application-level KeyShortcut.
+	KeyBindingSourceApplication
+	// KeyBindingSourceApplicationKeymap is an Application scope binding in
+	// the Application's Keymap.
+	KeyBindingSourceApplicationKeymap
+	// KeyBindingSourceViewActiveKey is a key that makes a View the active
+	// View.
+	KeyBindingSourceViewActiveKey
+	// KeyBindingSourceFocused is a KeyShortcut of the focused Element or one
+	// of its ancestors, or a Keymap binding for one of their classes. It is
+	// offered a key press before the View's and Application's bindings.
+	KeyBindingSourceFocused
+	// KeyBindingSourceViewKeymap is a binding for the active View in the
+	// Application's Keymap.
+	KeyBindingSourceViewKeymap
+	// KeyBindingSourceView is a KeyShortcut of the active View. The active
+	// View's KeyShortcuts and Keymap bindings are offered a key press before
+	// the Application's KeyShortcuts and Keymap.
+	KeyBindingSourceView
+	// KeyBindingSourceDescendant is a KeyShortcut of a
+	// ViewKeyShortcutHandler, e.g. a TabGroup, contained in the active View.
+	KeyBindingSourceDescendant
+	// KeyBindingSourceHelp is an Application key that toggles the key
+	// binding help overlay. It is offered a key press only when nothing else
+	// handles it.
+	KeyBindingSourceHelp
+)
+
+// String returns a short description of the KeyBindingSource.
+func (s KeyBindingSource) String() string {
+	switch s {
+	case KeyBindingSourceExit:
+		return "exit key"
+	case KeyBindingSourceInterceptor:
+		return "interceptor"
+	case KeyBindingSourceFocusNext:
+		return "focus next key"
+	case KeyBindingSourceApplication:
+		return "application shortcut"
+	case KeyBindingSourceApplicationKeymap:
+		return "application keymap"
+	case KeyBindingSourceViewActiveKey:
+		return "view active key"
+	case KeyBindingSourceFocused:
+		return "focused element"
+	case KeyBindingSourceViewKeymap:
+		return "view keymap"
+	case KeyBindingSourceView:
+		return "view shortcut"
+	case KeyBindingSourceDescendant:
+		return "descendant shortcut"
+	case KeyBindingSourceHelp:
+		return "help key"
+	}
+	return "unknown"
+}
+
+// ResolvedKeyBinding describes a Key that is currently bound somewhere in an
+// Application, resolved against every other current binding.
+type ResolvedKeyBinding struct {
+	// Key is the bound key press combination or sequence.
+	Key Key
+	// Source is where the binding was registered.
+	Source KeyBindingSource
+	// Owner is the ID of the View or Element that owns the binding, or the
+	// class of a Keymap class binding. It is empty for Application bindings.
+	Owner string
+	// Description is a short, human-readable description of what the
+	// binding does.
+	Description string
+	// Action is the name of the Action the binding triggers, for Keymap
+	// bindings.
+	Action string
+	// Mode is the name of the Mode whose Keymap contains the binding, for
+	// bindings that only apply in the current Mode.
+	Mode string
+	// ShadowedBy is the binding that wins over this one, or nil if this
+	// binding wins.
+	ShadowedBy *ResolvedKeyBinding
+}
+
+// Wins returns true if no other binding wins over the ResolvedKeyBinding.
+func (b ResolvedKeyBinding) Wins() bool {
+	return b.ShadowedBy == nil
+}
+
+// String returns a description of the ResolvedKeyBinding, e.g.
+// `"ctrl+s" view shortcut (main): save`.
+func (b ResolvedKeyBinding) String() string {
+	sb := strings.Builder{}
+	fmt.Fprintf(&sb, "%q %s", b.Key.String(), b.Source)
+	if b.Owner != "" {
+		fmt.Fprintf(&sb, " (%s)", b.Owner)
+	}
+	if b.Mode != "" {
+		fmt.Fprintf(&sb, " [%s]", b.Mode)
+	}
+	desc := b.Description
+	if desc == "" {
+		desc = b.Action
+	}
+	if desc != "" {
+		sb.WriteString(": " + desc)
+	}
+	return sb.String()
+}
+
+// KeyBindingProvider describes a thing, typically the Application, that can
+// list the key bindings currently active.
+type KeyBindingProvider interface {
+	// KeyBindings returns every key binding currently active, in the order
+	// a key press is offered to them.
+	KeyBindings() []ResolvedKeyBinding
+}
+
+// KeyBindingConflict describes a Key bound more than once, where only the
+// winning binding is ever triggered.
+type KeyBindingConflict struct {
+	// Key is the key press combination or sequence bound more than once.
+	Key Key
+	// Winner is the binding that is triggered when Key is pressed.
+	Winner ResolvedKeyBinding
+	// Shadowed are the bindings of Key that are never triggered.
+	Shadowed []ResolvedKeyBinding
+}
+
+// String returns a description of the KeyBindingConflict.
+func (c KeyBindingConflict) String() string {
+	shadowed := make([]string, 0, len(c.Shadowed))
+	for _, b := range c.Shadowed {
+		shadowed = append(shadowed, b.String())
+	}
+	return fmt.Sprintf(
+		"%s shadows %s", c.Winner, strings.Join(shadowed, ", "),
+	)
+}
@@ -0,0 +1,66 @@
+package types
+
+// KeymapScopeKind is the kind of thing a KeymapScope refers to.
+type KeymapScopeKind uint8
+
+const (
+	// KeymapScopeApplication indicates bindings that apply everywhere in
+	// the Application.
+	KeymapScopeApplication KeymapScopeKind = iota
+	// KeymapScopeView indicates bindings that apply while a View, named by
+	// its ID, is active.
+	KeymapScopeView
+	// KeymapScopeClass indicates bindings that apply while an Element of a
+	// class, e.g. "gt.textarea", or one of its descendants has the focus.
+	KeymapScopeClass
+)
+
+// KeymapScope identifies where a KeyBinding applies.
+type KeymapScope struct {
+	// Kind is the kind of thing the KeymapScope refers to.
+	Kind KeymapScopeKind
+	// Name is the View ID for a View scope or the Element class for a class
+	// scope. It is empty for the Application scope.
+	Name string
+}
+
+// String returns "application", "view:<id>" or "class:<class>".
+func (s KeymapScope) String() string {
+	switch s.Kind {
+	case KeymapScopeView:
+		return "view:" + s.Name
+	case KeymapScopeClass:
+		return "class:" + s.Name
+	}
+	return "application"
+}
+
+// KeyBinding binds a Key to the name of an Action within a KeymapScope.
+type KeyBinding struct {
+	// Scope is where the KeyBinding applies.
+	Scope KeymapScope
+	// Key is the key press combination or sequence that triggers the Action.
+	Key Key
+	// Action is the name of the Action triggered.
+	Action string
+}
+
+// Keymap maps Keys to the names of Actions, per KeymapScope.
+type Keymap interface {
+	// Bind binds the supplied Key to the named Action within the supplied
+	// KeymapScope, replacing any Action the Key was bound to in that
+	// KeymapScope.
+	Bind(KeymapScope, Key, string)
+	// Unbind removes any binding of the supplied Key within the supplied
+	// KeymapScope.
+	Unbind(KeymapScope, Key)
+	// Lookup returns the name of the Action the supplied Key is bound to
+	// within the supplied KeymapScope and whether the Key is bound.
+	Lookup(KeymapScope, Key) (string, bool)
+	// Bindings returns all KeyBindings in the order they were bound.
+	Bindings() []KeyBinding
+}
+
+// KeymapWithOption describes an optional varg parameter to [keymap.New] that
+// modifies the returned Keymap.
+type KeymapWithOption func(Keymap)
@@ -13,4 +13,10 @@ type KeyModifiable interface {
 	Shift() bool
 	// Alt returns true if the Alt modifier key was held.
 	Alt() bool
+	// Meta returns true if the Meta modifier key was held.
+	Meta() bool
+	// Super returns true if the Super modifier key was held.
+	Super() bool
+	// Hyper returns true if the Hyper modifier key was held.
+	Hyper() bool
 }
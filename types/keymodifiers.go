@@ -7,10 +7,15 @@ import (
 // KeyModifiers is a bitmask for modifier keys
 type KeyModifiers uint8
 
+// The Shift, Ctrl, Alt, Meta and Hyper bits match those of tcell's ModMask.
+// Most terminals report the Meta key as Alt.
 const (
 	KeyModifierShift KeyModifiers = 1 << iota
 	KeyModifierCtrl
 	KeyModifierAlt
+	KeyModifierMeta
+	KeyModifierHyper
+	KeyModifierSuper
 	KeyModifierNone = 0
 )
 
@@ -24,16 +29,31 @@ func (m KeyModifiers) Shift() bool {
 	return m&KeyModifierShift != 0
 }
 
-// Shift returns true if the KeyModifiers Ctrl bit is on.
+// Ctrl returns true if the KeyModifiers Ctrl bit is on.
 func (m KeyModifiers) Ctrl() bool {
 	return m&KeyModifierCtrl != 0
 }
 
-// Shift returns true if the KeyModifiers Alt bit is on.
+// Alt returns true if the KeyModifiers Alt bit is on.
 func (m KeyModifiers) Alt() bool {
 	return m&KeyModifierAlt != 0
 }
 
+// Meta returns true if the KeyModifiers Meta bit is on.
+func (m KeyModifiers) Meta() bool {
+	return m&KeyModifierMeta != 0
+}
+
+// Super returns true if the KeyModifiers Super bit is on.
+func (m KeyModifiers) Super() bool {
+	return m&KeyModifierSuper != 0
+}
+
+// Hyper returns true if the KeyModifiers Hyper bit is on.
+func (m KeyModifiers) Hyper() bool {
+	return m&KeyModifierHyper != 0
+}
+
 // String returns a string representation of all enabled bits in the
 // KeyModifiers bitmask.
 func (m KeyModifiers) String() string {
@@ -50,5 +70,14 @@ func (m KeyModifiers) String() string {
 	if m&KeyModifierAlt != 0 {
 		mods = append(mods, "alt")
 	}
+	if m&KeyModifierMeta != 0 {
+		mods = append(mods, "meta")
+	}
+	if m&KeyModifierSuper != 0 {
+		mods = append(mods, "super")
+	}
+	if m&KeyModifierHyper != 0 {
+		mods = append(mods, "hyper")
+	}
 	return strings.Join(mods, "+")
 }
@@ -3,7 +3,7 @@ package types
 import "context"
 
 // KeyShortcut describes an action taken when a specific Key is pressed by the
-// user.
+// user. The Key may be a sequence of key press combinations, e.g. "g g".
 type KeyShortcut interface {
 	// Key returns the specific keypress combination that will fire the
 	// shortcut action.
@@ -11,6 +11,11 @@ type KeyShortcut interface {
 	// SetKey sets the specific keypress combination that will fire the
 	// shortcut action.
 	SetKey(Key)
+	// Description returns a short, human-readable description of what the
+	// shortcut does, e.g. "save the file".
+	Description() string
+	// SetDescription sets the description of what the shortcut does.
+	SetDescription(string)
 	// Callback returns the KeyShortcutCallback that will be executed when the
 	// shortcut's keypress combination is pressed by the user.
 	Callback() KeyShortcutCallback
@@ -33,6 +38,20 @@ type KeyShortcutHandler interface {
 	// SetKeyShortcut registers a KeyShortcut for the KeyShortcutHandler. If
 	// the KeyShortcutHandler already has a KeyShortcut for the registered
 	// KeyShortcut's Key, a warning will be sent to the gt log that the new
-	// KeyShortcut shadows a previously-registered KeyShortcut.
+	// KeyShortcut is shadowed by the previously-registered KeyShortcut, which
+	// continues to win.
 	SetKeyShortcut(KeyShortcut)
+	// KeyShortcuts returns the KeyShortcuts registered for the
+	// KeyShortcutHandler.
+	KeyShortcuts() []KeyShortcut
+}
+
+// ViewKeyShortcutHandler describes a thing, such as a TabGroup, whose
+// KeyShortcuts are active whenever the View containing it is active, rather
+// than only while the focus is within it like an Element's KeyShortcuts.
+type ViewKeyShortcutHandler interface {
+	KeyShortcutHandler
+	// ViewKeyShortcuts returns the KeyShortcuts that are active whenever the
+	// View containing the ViewKeyShortcutHandler is active.
+	ViewKeyShortcuts() []KeyShortcut
 }
@@ -0,0 +1,36 @@
+package types
+
+import "context"
+
+// Mode is a named input mode of an Application, such as vim's "normal",
+// "insert" and "command" modes, having its own Keymap.
+type Mode interface {
+	// Name returns the Mode's unique name.
+	Name() string
+	// Keymap returns the Keymap whose bindings apply while the Mode is the
+	// current Mode, or nil.
+	Keymap() Keymap
+	// SetKeymap sets the Keymap whose bindings apply while the Mode is the
+	// current Mode.
+	SetKeymap(Keymap)
+	// Insert returns true if the Mode is an insert-like Mode that the
+	// Application switches to while a text input intercepts key presses.
+	Insert() bool
+	// SetInsert sets whether the Mode is an insert-like Mode.
+	SetInsert(bool)
+}
+
+// ModeWithOption describes an optional varg parameter to [core.mode.New]
+// that modifies the returned Mode.
+type ModeWithOption func(Mode)
+
+// ModeChangeCallback is executed when the Application's current Mode changes
+// from one Mode to another. Either Mode may be nil.
+type ModeChangeCallback func(ctx context.Context, from, to Mode)
+
+// ModeProvider describes a thing, typically the Application, that has a
+// current Mode.
+type ModeProvider interface {
+	// CurrentMode returns the current Mode, or nil if no Mode is set.
+	CurrentMode() Mode
+}
@@ -2,7 +2,7 @@ package types
 
 // Motif is a design pattern for an Element that encapsulates different styles
 // and borders when the Element is in various states (focused, being hovered
-// over with the mouse, disabled, etc)
+// over with the mouse, disabled, pressed, selected, checked, invalid, etc)
 type Motif interface {
 	// Unstyled returns true if the Motif has no styling set up.
 	Unstyled() bool
@@ -72,6 +72,66 @@ type Motif interface {
 	// WithHoveredBorder sets the border for when the mouse is hovering over
 	// the thing and returns the Motif.
 	WithHoveredBorder(Border) Motif
+	// PressedStyle returns the styling for when a mouse button is held down on
+	// the thing.
+	PressedStyle() Style
+	// SetPressedStyle sets the styling for when a mouse button is held down on
+	// the thing.
+	SetPressedStyle(Style)
+	// WithPressedStyle sets the styling for when a mouse button is held down on
+	// the thing and returns the Motif.
+	WithPressedStyle(Style) Motif
+	// PressedBorder returns the border for when a mouse button is held down on
+	// the thing.
+	PressedBorder() Border
+	// SetPressedBorder sets the border for when a mouse button is held down on
+	// the thing.
+	SetPressedBorder(Border)
+	// WithPressedBorder sets the border for when a mouse button is held down on
+	// the thing and returns the Motif.
+	WithPressedBorder(Border) Motif
+	// SelectedStyle returns the styling for when the thing is selected.
+	SelectedStyle() Style
+	// SetSelectedStyle sets the styling for when the thing is selected.
+	SetSelectedStyle(Style)
+	// WithSelectedStyle sets the styling for when the thing is selected and
+	// returns the Motif.
+	WithSelectedStyle(Style) Motif
+	// SelectedBorder returns the border for when the thing is selected.
+	SelectedBorder() Border
+	// SetSelectedBorder sets the border for when the thing is selected.
+	SetSelectedBorder(Border)
+	// WithSelectedBorder sets the border for when the thing is selected and
+	// returns the Motif.
+	WithSelectedBorder(Border) Motif
+	// CheckedStyle returns the styling for when the thing is checked.
+	CheckedStyle() Style
+	// SetCheckedStyle sets the styling for when the thing is checked.
+	SetCheckedStyle(Style)
+	// WithCheckedStyle sets the styling for when the thing is checked and
+	// returns the Motif.
+	WithCheckedStyle(Style) Motif
+	// CheckedBorder returns the border for when the thing is checked.
+	CheckedBorder() Border
+	// SetCheckedBorder sets the border for when the thing is checked.
+	SetCheckedBorder(Border)
+	// WithCheckedBorder sets the border for when the thing is checked and
+	// returns the Motif.
+	WithCheckedBorder(Border) Motif
+	// InvalidStyle returns the styling for when the thing has an invalid value.
+	InvalidStyle() Style
+	// SetInvalidStyle sets the styling for when the thing has an invalid value.
+	SetInvalidStyle(Style)
+	// WithInvalidStyle sets the styling for when the thing has an invalid value
+	// and returns the Motif.
+	WithInvalidStyle(Style) Motif
+	// InvalidBorder returns the border for when the thing has an invalid value.
+	InvalidBorder() Border
+	// SetInvalidBorder sets the border for when the thing has an invalid value.
+	SetInvalidBorder(Border)
+	// WithInvalidBorder sets the border for when the thing has an invalid value
+	// and returns the Motif.
+	WithInvalidBorder(Border) Motif
 }
 
 // MotifWithOption describes an optional varg parameter to [motif.New] that
@@ -62,6 +62,15 @@ type MouseHoverEvent interface {
 	Hovered() bool
 }
 
+// MousePressEvent describes events received when a mouse button is pressed
+// down on something and when it is released.
+type MousePressEvent interface {
+	MouseEvent
+	// Pressed returns true if the mouse button is held down on the receiver
+	// of the event and false if it was released.
+	Pressed() bool
+}
+
 // MouseClickEvent describes a mouse event for when the user clicked or
 // double-clicked a mouse button.
 type MouseClickEvent interface {
@@ -91,6 +100,10 @@ type MouseEventCallback func(context.Context, MouseEvent)
 // mouse hover events.
 type MouseHoverEventCallback func(context.Context, MouseHoverEvent)
 
+// MousePressEventCallback is the function signature for callbacks executed on
+// mouse press and release events.
+type MousePressEventCallback func(context.Context, MousePressEvent)
+
 // MouseClickEventCallback is the function signature for callbacks executed on
 // mouse click and double-click events.
 type MouseClickEventCallback func(context.Context, MouseClickEvent)
@@ -107,6 +120,13 @@ type MouseEventHandler interface {
 	// OnMouseHover registers a callback that will be executed when the mouse
 	// is over top of an element but the element does *not* have the focus.
 	OnMouseHover(MouseHoverEventCallback)
+	// MousePress executes any OnMousePress callbacks that were registered for
+	// the MouseEventHandler.
+	MousePress(context.Context, MousePressEvent)
+	// OnMousePress registers a callback that will be executed when a mouse
+	// button is pressed down on the MouseEventHandler and when it is
+	// released.
+	OnMousePress(MousePressEventCallback)
 	// MouseClick executes any OnMouseClick callbacks that were registered for
 	// the MouseEventHandler.
 	MouseClick(context.Context, MouseClickEvent)
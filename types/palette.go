@@ -21,3 +21,30 @@ type Palette interface {
 // PaletteWithOption describes an optional varg parameter to [palette.New] that
 // modifies the returned Palette.
 type PaletteWithOption func(Palette)
+
+// PaletteANSIColors is the full set of 256 colors addressable by ANSI escape
+// sequences on an xterm-compatible terminal: the 16 terminal colors, the
+// 6x6x6 color cube at indexes 16-231 and the 24-step grayscale ramp at indexes
+// 232-255.
+type PaletteANSIColors [256]color.Color
+
+// ExtendedPalette is a Palette that also describes the colors of a terminal,
+// including its default foreground and background colors and the 256 colors
+// addressable by ANSI escape sequences.
+type ExtendedPalette interface {
+	Palette
+	// ANSIColors returns the ExtendedPalette's 256 ANSI colors.
+	ANSIColors() PaletteANSIColors
+	// SetANSIColors sets the ExtendedPalette's 256 ANSI colors.
+	SetANSIColors(PaletteANSIColors)
+	// ANSI returns the ANSI color at the specified index.
+	ANSI(int) Color
+	// Foreground returns the terminal's default foreground color.
+	Foreground() Color
+	// SetForeground sets the terminal's default foreground color.
+	SetForeground(Color)
+	// Background returns the terminal's default background color.
+	Background() Color
+	// SetBackground sets the terminal's default background color.
+	SetBackground(Color)
+}
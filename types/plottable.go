@@ -22,6 +22,13 @@ type Plottable interface {
 
 	// SetBounds sets the Plottable's outer bounding box.
 	SetBounds(Rectangle)
+	// SetPlottedBounds sets the Plottable's outer bounding box as calculated
+	// when plotting the Plottable. Unlike bounds set with SetBounds, plotted
+	// bounds are cleared by Invalidate.
+	SetPlottedBounds(Rectangle)
+	// Invalidate clears any plotted bounds of the Plottable so that they are
+	// calculated again the next time the Plottable is plotted.
+	Invalidate()
 	// SetAbsolutePosition sets the Plottable's outer bounding box's top-left
 	// coordinates and marks the Plottable as using absolute positioning.
 	SetAbsolutePosition(Point)
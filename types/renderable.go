@@ -8,3 +8,10 @@ type Renderable interface {
 	// ScreenHandler.
 	Render(context.Context, ScreenHandler)
 }
+
+// Redrawer is something, typically the Application, that can be asked to
+// redraw the terminal screen.
+type Redrawer interface {
+	// Redraw schedules the terminal screen to be redrawn.
+	Redraw()
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	"github.com/gdamore/tcell/v3"
+)
+
+// SessionPlayer replays the input events of a recorded session.
+type SessionPlayer interface {
+	// Speed returns how fast the SessionPlayer replays events relative to
+	// the speed they were recorded at, e.g. 2 for twice as fast. A speed of
+	// 0 replays events without any delay between them.
+	Speed() float64
+	// SetSpeed sets how fast the SessionPlayer replays events relative to
+	// the speed they were recorded at.
+	SetSpeed(float64)
+	// Step returns whether the SessionPlayer waits to be advanced before
+	// replaying each event, instead of replaying events at their recorded
+	// times.
+	Step() bool
+	// SetStep sets whether the SessionPlayer waits to be advanced before
+	// replaying each event.
+	SetStep(bool)
+	// Len returns the number of events being replayed.
+	Len() int
+	// Position returns the index of the next event to replay.
+	Position() int
+	// Done returns true if every event has been replayed.
+	Done() bool
+	// Reset rewinds the SessionPlayer to the first event.
+	Reset()
+	// Next returns the next event along with how long to wait before
+	// replaying it. It returns false once every event has been replayed.
+	Next() (tcell.Event, time.Duration, bool)
+	// Play replays the remaining events by passing each one to the supplied
+	// post function, waiting for a value on the supplied advance channel
+	// before each event when stepping.
+	Play(context.Context, func(tcell.Event), <-chan struct{}) error
+}
+
+// SessionPlayerWithOption describes an optional varg parameter to
+// [session.NewPlayer] that modifies the returned SessionPlayer.
+type SessionPlayerWithOption func(SessionPlayer)
@@ -0,0 +1,36 @@
+package types
+
+// Shadow describes a drop shadow drawn outside the bottom and right edges of a
+// bounding box, typically used for floating boxes like dialogs and popovers.
+type Shadow interface {
+	// Offset returns the number of cells and lines the Shadow is offset to
+	// the right and below the bounding box casting it.
+	Offset() Point
+	// SetOffset sets the number of cells and lines the Shadow is offset to
+	// the right and below the bounding box casting it.
+	SetOffset(Point)
+	// Content returns the grapheme used to draw the Shadow's cells.
+	Content() string
+	// SetContent sets the grapheme used to draw the Shadow's cells.
+	SetContent(string)
+	// ForegroundColor returns the foreground color of the Shadow's cells.
+	ForegroundColor() Color
+	// SetForegroundColor sets the foreground color of the Shadow's cells.
+	SetForegroundColor(Color)
+	// BackgroundColor returns the background color of the Shadow's cells.
+	BackgroundColor() Color
+	// SetBackgroundColor sets the background color of the Shadow's cells.
+	SetBackgroundColor(Color)
+}
+
+// ShadowWithOption describes an optional varg parameter to [shadow.New] that
+// modifies the returned Shadow.
+type ShadowWithOption func(Shadow)
+
+// Shadowed describes something that can cast a Shadow.
+type Shadowed interface {
+	// Shadow returns the Shadowed's Shadow, if any.
+	Shadow() Shadow
+	// SetShadow sets the Shadowed's Shadow.
+	SetShadow(Shadow)
+}
@@ -7,6 +7,10 @@ type Style interface {
 	fmt.Stringer
 	// Unstyled returns true if there is no styling applied.
 	Unstyled() bool
+	// Specified returns true if the Style explicitly sets the supplied
+	// Property, even to its initial value. Inherited Properties that the
+	// Style does not specify are taken from the parent Element's Style.
+	Specified(Property) bool
 	// SetBold returns whether the Style's bold attribute is set.
 	Bold() bool
 	// SetBold sets the Style's bold attribute.
@@ -28,6 +32,10 @@ type Style interface {
 	Blink() bool
 	// SetBlink sets the Style's blink attribute.
 	SetBlink(bool)
+	// Reverse returns whether the Style's reverse attribute is set.
+	Reverse() bool
+	// SetReverse sets the Style's reverse attribute.
+	SetReverse(bool)
 	// Underline returns whether the Style's underline style is not none.
 	Underline() bool
 	// UnderlineStyle sets the Style's underline style.
@@ -46,6 +54,33 @@ type Style interface {
 	UnderlineColor() Color
 	// SetUnderlineColor sets the Style's underline color.
 	SetUnderlineColor(Color)
+	// Hyperlink returns the URL and ID of the Style's OSC 8 hyperlink. The
+	// URL is empty if the Style is not a hyperlink.
+	Hyperlink() (url string, id string)
+	// SetHyperlink sets the URL and optional ID of the Style's OSC 8
+	// hyperlink. Terminals that support OSC 8 group text having the same ID
+	// into a single link, even across lines. An empty URL removes the link.
+	SetHyperlink(url string, id string)
+}
+
+// Hyperlink is the value of a [PropertyHyperlink] Declaration.
+type Hyperlink struct {
+	// URL is the target of the hyperlink.
+	URL string
+	// ID is the optional ID grouping separate pieces of text into a single
+	// hyperlink.
+	ID string
+}
+
+// String returns the Hyperlink's URL followed by its ID, if any.
+func (h Hyperlink) String() string {
+	if h.URL == "" {
+		return "none"
+	}
+	if h.ID == "" {
+		return h.URL
+	}
+	return fmt.Sprintf("%s (id=%s)", h.URL, h.ID)
 }
 
 // StyleWithOption describes an optional varg parameter to [style.New] that
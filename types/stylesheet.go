@@ -0,0 +1,155 @@
+package types
+
+import (
+	"context"
+	"fmt"
+)
+
+// Property identifies an Element property that a stylesheet Rule can set.
+type Property string
+
+const (
+	// PropertyForegroundColor is the foreground color of the Element's
+	// Style. Its value is a Color.
+	PropertyForegroundColor Property = "foreground-color"
+	// PropertyBackgroundColor is the background color of the Element's
+	// Style. Its value is a Color.
+	PropertyBackgroundColor Property = "background-color"
+	// PropertyBold is the bold attribute of the Element's Style. Its value is
+	// a bool.
+	PropertyBold Property = "bold"
+	// PropertyItalic is the italic attribute of the Element's Style. Its
+	// value is a bool.
+	PropertyItalic Property = "italic"
+	// PropertyDim is the dim attribute of the Element's Style. Its value is a
+	// bool.
+	PropertyDim Property = "dim"
+	// PropertyUnderline is the underline style of the Element's Style. Its
+	// value is an UnderlineStyle.
+	PropertyUnderline Property = "underline"
+	// PropertyStrikethrough is the strikethrough attribute of the Element's
+	// Style. Its value is a bool.
+	PropertyStrikethrough Property = "strikethrough"
+	// PropertyBlink is the blink attribute of the Element's Style. Its value
+	// is a bool.
+	PropertyBlink Property = "blink"
+	// PropertyReverse is the reverse attribute of the Element's Style. Its
+	// value is a bool.
+	PropertyReverse Property = "reverse"
+	// PropertyHyperlink is the OSC 8 hyperlink of the Element's Style. Its
+	// value is a Hyperlink.
+	PropertyHyperlink Property = "hyperlink"
+	// PropertyBorder is the Element's Border. Its value is a Border.
+	PropertyBorder Property = "border"
+	// PropertyPadding is the Element's Padding. Its value is a Padding.
+	PropertyPadding Property = "padding"
+	// PropertyWidth is the Element's width. Its value is a
+	// DimensionConstraint.
+	PropertyWidth Property = "width"
+	// PropertyHeight is the Element's height. Its value is a
+	// DimensionConstraint.
+	PropertyHeight Property = "height"
+	// PropertyAlignment is the Element's Alignment. Its value is an
+	// Alignment.
+	PropertyAlignment Property = "alignment"
+	// PropertyDisplay is the Element's Display. Its value is a Display.
+	PropertyDisplay Property = "display"
+)
+
+// Inherited returns true if an Element that does not set the Property takes
+// its value from its parent Element. Colors and text attributes are
+// inherited. Hyperlinks, borders, padding, size, alignment and display are
+// not, so that a hyperlink only makes the text of the Element setting it
+// clickable.
+func (p Property) Inherited() bool {
+	switch p {
+	case PropertyForegroundColor, PropertyBackgroundColor, PropertyBold,
+		PropertyItalic, PropertyDim, PropertyUnderline,
+		PropertyStrikethrough, PropertyBlink, PropertyReverse:
+		return true
+	}
+	return false
+}
+
+// Keyword is a Declaration value that applies to any Property.
+type Keyword string
+
+const (
+	// Inherit sets the Property to the value of the parent Element's
+	// Property, even if the Property is not normally inherited.
+	Inherit Keyword = "inherit"
+	// Initial sets the Property to its initial value, ignoring both the
+	// Element's own value and any inherited value.
+	Initial Keyword = "initial"
+)
+
+// Declaration sets a single Property to a value.
+type Declaration struct {
+	// Property is the Property being set.
+	Property Property
+	// Value is the value of the Property.
+	Value any
+}
+
+// Specificity is the weight of a Selector, used to decide which of several
+// conflicting Declarations applies to an Element. Its elements are the number
+// of IDs, the number of state pseudo-classes and the number of Element classes
+// in the Selector.
+type Specificity [3]int
+
+// Less returns true if the Specificity is lower than the supplied Specificity.
+func (s Specificity) Less(other Specificity) bool {
+	for x := range s {
+		if s[x] != other[x] {
+			return s[x] < other[x]
+		}
+	}
+	return false
+}
+
+// Selector matches Elements in a tree of Elements.
+type Selector interface {
+	fmt.Stringer
+	// Matches returns true if the Selector matches the supplied Element.
+	Matches(Element) bool
+	// Specificity returns the Selector's Specificity.
+	Specificity() Specificity
+}
+
+// Rule is a set of Declarations applying to the Elements matched by a
+// Selector.
+type Rule interface {
+	fmt.Stringer
+	// Selectors returns the Rule's Selectors. The Rule applies to Elements
+	// matched by any of its Selectors.
+	Selectors() []Selector
+	// Declarations returns the Rule's Declarations.
+	Declarations() []Declaration
+}
+
+// Stylesheet is an ordered collection of Rules.
+type Stylesheet interface {
+	// Rules returns the Stylesheet's Rules, in order.
+	Rules() []Rule
+	// AddRule appends a Rule to the Stylesheet.
+	AddRule(Rule)
+}
+
+// StylesheetWithOption describes an optional varg parameter to
+// [stylesheet.New] that modifies the returned Stylesheet.
+type StylesheetWithOption func(Stylesheet)
+
+// StylesheetProvider represents something, like an Application, that provides
+// a Stylesheet to the things it contains.
+type StylesheetProvider interface {
+	// Stylesheet returns the StylesheetProvider's Stylesheet, if any.
+	Stylesheet() Stylesheet
+}
+
+// Cascadable represents something whose properties are computed from the
+// Stylesheets that apply to it.
+type Cascadable interface {
+	// Cascade computes the properties of the Cascadable from the Rules of
+	// the Stylesheets that apply to it.
+	Cascade(context.Context)
+}
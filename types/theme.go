@@ -2,7 +2,7 @@ package types
 
 // ThemeClass represents a class of thing that a Theme will style.
 //
-// There are four built-in ThemeClasses, but since ThemeClass is a string,
+// There are five built-in ThemeClasses, but since ThemeClass is a string,
 // users can create their own ThemeClasses and add greater extensibility to
 // their styled Elements and Components.
 type ThemeClass string
@@ -18,14 +18,20 @@ const (
 	// * Checkbox
 	ThemeClassInput = "gt.input"
 	// ThemeClassNavigation is for Elements that provide navigation
-	// functionality for the user.
+	// functionality for the user. By default, the bar of a TabGroup has a
+	// ThemeClass of ThemeClassNavigation.
 	ThemeClassNavigation = "gt.navigation"
 	// ThemeClassPrimary is for Elements that make up the primary non-input,
-	// non-navigation components of the application.
+	// non-navigation components of the application. By default, Button has a
+	// ThemeClass of ThemeClassPrimary.
 	ThemeClassPrimary = "gt.primary"
 	// ThemeClassSecondary is for Elements that make up the non-primary,
 	// non-input, non-navigation components of the application.
 	ThemeClassSecondary = "gt.secondary"
+	// ThemeClassHelp is for the Application's key binding help overlay.
+	// When a Theme has no Motif, Style or Border for ThemeClassHelp, the
+	// ones for ThemeClassPrimary are used.
+	ThemeClassHelp = "gt.help"
 )
 
 // Theme describes a set of Motifs, Style and Border properties that can apply
@@ -11,3 +11,10 @@ type Themeable interface {
 	// SetTheme sets the Themeable's Theme.
 	SetTheme(Theme)
 }
+
+// ThemeProvider represents something, like an Application, that provides a
+// Theme to the things it contains.
+type ThemeProvider interface {
+	// Theme returns the ThemeProvider's Theme, if any.
+	Theme() Theme
+}
@@ -12,7 +12,24 @@ type View interface {
 	fmt.Stringer
 	Identifiable
 	KeyPressEventHandler
+	KeyShortcutHandler
 	Plottable
+	Themeable
+
+	// SetThemeProvider sets the thing, typically the Application, that
+	// provides the Theme for the View's Elements when neither the View nor any
+	// of those Elements has a Theme.
+	SetThemeProvider(ThemeProvider)
+
+	// Stylesheet returns the View's Stylesheet, if any.
+	Stylesheet() Stylesheet
+	// SetStylesheet sets the View's Stylesheet. The Stylesheet's Rules apply
+	// to all Elements in the View.
+	SetStylesheet(Stylesheet)
+	// SetStylesheetProvider sets the thing, typically the Application, that
+	// provides a Stylesheet whose Rules apply to all Elements in the View.
+	// The View's own Stylesheet takes precedence over it.
+	SetStylesheetProvider(StylesheetProvider)
 
 	// WithID sets the View's unique identifier and returns the View.
 	WithID(string) View